@@ -42,10 +42,13 @@ type antiSpywareProfilesListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Name   types.String `tfsdk:"name"`
-	Folder types.String `tfsdk:"folder"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Name     types.String `tfsdk:"name"`
+	Folder   types.String `tfsdk:"folder"`
 
 	// Output.
 	Data []antiSpywareProfilesListDsModelConfig `tfsdk:"data"`
@@ -139,6 +142,27 @@ func (d *antiSpywareProfilesListDataSource) Schema(_ context.Context, _ datasour
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"name": dsschema.StringAttribute{
 				Description:         "The name of the entry.",
 				MarkdownDescription: "The name of the entry.",
@@ -417,12 +441,32 @@ func (d *antiSpywareProfilesListDataSource) Read(ctx context.Context, req dataso
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -1037,9 +1081,10 @@ func (d *antiSpywareProfilesDataSource) Read(ctx context.Context, req datasource
 
 // Resource.
 var (
-	_ resource.Resource                = &antiSpywareProfilesResource{}
-	_ resource.ResourceWithConfigure   = &antiSpywareProfilesResource{}
-	_ resource.ResourceWithImportState = &antiSpywareProfilesResource{}
+	_ resource.Resource                     = &antiSpywareProfilesResource{}
+	_ resource.ResourceWithConfigure        = &antiSpywareProfilesResource{}
+	_ resource.ResourceWithConfigValidators = &antiSpywareProfilesResource{}
+	_ resource.ResourceWithImportState      = &antiSpywareProfilesResource{}
 )
 
 func NewAntiSpywareProfilesResource() resource.Resource {
@@ -1051,7 +1096,8 @@ type antiSpywareProfilesResource struct {
 }
 
 type antiSpywareProfilesRsModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
 
 	// Input.
 	Folder types.String `tfsdk:"folder"`
@@ -1140,6 +1186,9 @@ func (r *antiSpywareProfilesResource) Schema(_ context.Context, _ resource.Schem
 				Validators: []validator.String{
 					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 
 			"description": rsschema.StringAttribute{
@@ -1419,6 +1468,36 @@ func (r *antiSpywareProfilesResource) Schema(_ context.Context, _ resource.Schem
 				},
 			},
 		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_anti_spyware_profiles"),
+		},
+	}
+}
+
+// ConfigValidators returns the checks for mutually exclusive attributes.
+func (r *antiSpywareProfilesResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		ExactlyOneOfNested(
+			path.MatchRoot("rules").AtAnyListIndex().AtName("action"),
+			"alert",
+			"allow",
+			"block_ip",
+			"drop",
+			"reset_both",
+			"reset_client",
+			"reset_server",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("threat_exception").AtAnyListIndex().AtName("action"),
+			"alert",
+			"allow",
+			"block_ip",
+			"default",
+			"drop",
+			"reset_both",
+			"reset_client",
+			"reset_server",
+		),
 	}
 }
 
@@ -1439,6 +1518,9 @@ func (r *antiSpywareProfilesResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_anti_spyware_profiles", "create", state.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource create", map[string]any{
 		"terraform_provider_function": "Create",
@@ -1561,7 +1643,7 @@ func (r *antiSpywareProfilesResource) Create(ctx context.Context, req resource.C
 	// Perform the operation.
 	ans, err := svc.Create(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in create", err.Error())
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
 		return
 	}
 
@@ -1696,6 +1778,13 @@ func (r *antiSpywareProfilesResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	var state antiSpywareProfilesRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_anti_spyware_profiles", "read", state.Timeouts)
+	defer cancel()
 
 	// Basic logging.
 	tflog.Info(ctx, "performing resource read", map[string]any{
@@ -1718,7 +1807,7 @@ func (r *antiSpywareProfilesResource) Read(ctx context.Context, req resource.Rea
 		if IsObjectNotFound(err) {
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error reading config", err.Error())
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
 		}
 		return
 	}
@@ -1849,6 +1938,9 @@ func (r *antiSpywareProfilesResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_anti_spyware_profiles", "update", plan.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource update", map[string]any{
 		"terraform_provider_function": "Update",
@@ -1971,11 +2063,12 @@ func (r *antiSpywareProfilesResource) Update(ctx context.Context, req resource.U
 	// Perform the operation.
 	ans, err := svc.Update(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in update", err.Error())
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
 		return
 	}
 
 	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
 	var var14 []antiSpywareProfilesRsModelRulesObject
 	if len(ans.Rules) != 0 {
 		var14 = make([]antiSpywareProfilesRsModelRulesObject, 0, len(ans.Rules))
@@ -2093,6 +2186,16 @@ func (r *antiSpywareProfilesResource) Delete(ctx context.Context, req resource.D
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_anti_spyware_profiles", "delete", timeouts)
+	defer cancel()
+
 	id := idType.ValueString()
 	tokens := strings.Split(id, IdSeparator)
 	if len(tokens) != 2 {
@@ -2115,10 +2218,59 @@ func (r *antiSpywareProfilesResource) Delete(ctx context.Context, req resource.D
 
 	// Perform the operation.
 	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
-		resp.Diagnostics.AddError("Error in delete", err.Error())
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
 	}
 }
 
+// ImportState imports by either the resource ID or by location params and name,
+// such as `folder=...,name=...`.
 func (r *antiSpywareProfilesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	params, ok, err := ParseImportId(req.ID, "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_anti_spyware_profiles",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := iGpoRYz.NewClient(r.client)
+	input := iGpoRYz.ListInput{
+		Folder: params["folder"],
+		Name:   api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/paloaltonetworks/sase-go/api"
 	iblCTtp "github.com/paloaltonetworks/sase-go/netsec/service/v1/antispywaresignatures"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -35,10 +36,13 @@ type antiSpywareSignaturesListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Name   types.String `tfsdk:"name"`
-	Folder types.String `tfsdk:"folder"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Name     types.String `tfsdk:"name"`
+	Folder   types.String `tfsdk:"folder"`
 
 	// Output.
 	Data []antiSpywareSignaturesListDsModelConfig `tfsdk:"data"`
@@ -142,6 +146,27 @@ func (d *antiSpywareSignaturesListDataSource) Schema(_ context.Context, _ dataso
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"name": dsschema.StringAttribute{
 				Description:         "The name of the entry.",
 				MarkdownDescription: "The name of the entry.",
@@ -463,12 +488,32 @@ func (d *antiSpywareSignaturesListDataSource) Read(ctx context.Context, req data
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
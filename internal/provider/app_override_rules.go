@@ -44,6 +44,9 @@ type appOverrideRulesListDsModel struct {
 	// Input.
 	Limit    types.Int64  `tfsdk:"limit"`
 	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
 	Position types.String `tfsdk:"position"`
 	Folder   types.String `tfsdk:"folder"`
 	Name     types.String `tfsdk:"name"`
@@ -103,6 +106,27 @@ func (d *appOverrideRulesListDataSource) Schema(_ context.Context, _ datasource.
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"position": dsschema.StringAttribute{
 				Description:         "The position of a security rule. Value must be one of: `\"pre\"`, `\"post\"`.",
 				MarkdownDescription: "The position of a security rule. Value must be one of: `\"pre\"`, `\"post\"`.",
@@ -270,12 +294,32 @@ func (d *appOverrideRulesListDataSource) Read(ctx context.Context, req datasourc
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -568,7 +612,8 @@ type appOverrideRulesResource struct {
 }
 
 type appOverrideRulesRsModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
 
 	// Input.
 	Position types.String `tfsdk:"position"`
@@ -621,6 +666,9 @@ func (r *appOverrideRulesResource) Schema(_ context.Context, _ resource.SchemaRe
 				Validators: []validator.String{
 					stringvalidator.OneOf("pre", "post"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"folder": rsschema.StringAttribute{
 				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
@@ -629,6 +677,9 @@ func (r *appOverrideRulesResource) Schema(_ context.Context, _ resource.SchemaRe
 				Validators: []validator.String{
 					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 
 			"application": rsschema.StringAttribute{
@@ -747,6 +798,9 @@ func (r *appOverrideRulesResource) Schema(_ context.Context, _ resource.SchemaRe
 				ElementType:         types.StringType,
 			},
 		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_app_override_rules"),
+		},
 	}
 }
 
@@ -767,6 +821,9 @@ func (r *appOverrideRulesResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_app_override_rules", "create", state.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource create", map[string]any{
 		"terraform_provider_function": "Create",
@@ -801,7 +858,7 @@ func (r *appOverrideRulesResource) Create(ctx context.Context, req resource.Crea
 	// Perform the operation.
 	ans, err := svc.Create(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in create", err.Error())
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
 		return
 	}
 
@@ -848,6 +905,13 @@ func (r *appOverrideRulesResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	var state appOverrideRulesRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_app_override_rules", "read", state.Timeouts)
+	defer cancel()
 
 	// Basic logging.
 	tflog.Info(ctx, "performing resource read", map[string]any{
@@ -870,7 +934,7 @@ func (r *appOverrideRulesResource) Read(ctx context.Context, req resource.ReadRe
 		if IsObjectNotFound(err) {
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error reading config", err.Error())
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
 		}
 		return
 	}
@@ -912,6 +976,9 @@ func (r *appOverrideRulesResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_app_override_rules", "update", plan.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource update", map[string]any{
 		"terraform_provider_function": "Update",
@@ -944,11 +1011,12 @@ func (r *appOverrideRulesResource) Update(ctx context.Context, req resource.Upda
 	// Perform the operation.
 	ans, err := svc.Update(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in update", err.Error())
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
 		return
 	}
 
 	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
 	state.Application = types.StringValue(ans.Application)
 	state.Description = types.StringValue(ans.Description)
 	state.Destination = EncodeStringSlice(ans.Destination)
@@ -976,6 +1044,16 @@ func (r *appOverrideRulesResource) Delete(ctx context.Context, req resource.Dele
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_app_override_rules", "delete", timeouts)
+	defer cancel()
+
 	id := idType.ValueString()
 	tokens := strings.Split(id, IdSeparator)
 	if len(tokens) != 3 {
@@ -998,10 +1076,62 @@ func (r *appOverrideRulesResource) Delete(ctx context.Context, req resource.Dele
 
 	// Perform the operation.
 	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
-		resp.Diagnostics.AddError("Error in delete", err.Error())
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
 	}
 }
 
+// ImportState imports by either the resource ID or by location params and name,
+// such as `position=...,folder=...,name=...`.
 func (r *appOverrideRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	params, ok, err := ParseImportId(req.ID, "position", "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_app_override_rules",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := pTgTBIe.NewClient(r.client)
+	input := pTgTBIe.ListInput{
+		Position: params["position"],
+		Folder:   params["folder"],
+		Name:     api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["position"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
 }
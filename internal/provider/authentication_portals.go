@@ -7,11 +7,18 @@ import (
 
 	"github.com/paloaltonetworks/sase-go"
 	"github.com/paloaltonetworks/sase-go/api"
+	hTqWmZc "github.com/paloaltonetworks/sase-go/netsec/schema/authentication/portals"
 	mfYmVgm "github.com/paloaltonetworks/sase-go/netsec/service/v1/authenticationportals"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rsschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -35,9 +42,12 @@ type authenticationPortalsListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Folder types.String `tfsdk:"folder"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Folder   types.String `tfsdk:"folder"`
 
 	// Output.
 	Data []authenticationPortalsListDsModelConfig `tfsdk:"data"`
@@ -87,6 +97,27 @@ func (d *authenticationPortalsListDataSource) Schema(_ context.Context, _ dataso
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"folder": dsschema.StringAttribute{
 				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
 				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
@@ -194,12 +225,32 @@ func (d *authenticationPortalsListDataSource) Read(ctx context.Context, req data
 		input.Offset = api.Int(state.Offset.ValueInt64())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -246,3 +297,578 @@ func (d *authenticationPortalsListDataSource) Read(ctx context.Context, req data
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// Data source.
+var (
+	_ datasource.DataSource              = &authenticationPortalsDataSource{}
+	_ datasource.DataSourceWithConfigure = &authenticationPortalsDataSource{}
+)
+
+func NewAuthenticationPortalsDataSource() datasource.DataSource {
+	return &authenticationPortalsDataSource{}
+}
+
+type authenticationPortalsDataSource struct {
+	client *sase.Client
+}
+
+type authenticationPortalsDsModel struct {
+	Id types.String `tfsdk:"id"`
+
+	// Input.
+	ObjectId types.String `tfsdk:"object_id"`
+	Folder   types.String `tfsdk:"folder"`
+
+	// Output.
+	// Ref: #/components/schemas/authentication-portals
+	AuthenticationProfile types.String `tfsdk:"authentication_profile"`
+	CertificateProfile    types.String `tfsdk:"certificate_profile"`
+	GpUdpPort             types.Int64  `tfsdk:"gp_udp_port"`
+	// input omit: ObjectId
+	IdleTimer         types.Int64  `tfsdk:"idle_timer"`
+	RedirectHost      types.String `tfsdk:"redirect_host"`
+	Timer             types.Int64  `tfsdk:"timer"`
+	TlsServiceProfile types.String `tfsdk:"tls_service_profile"`
+}
+
+// Metadata returns the data source type name.
+func (d *authenticationPortalsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authentication_portals"
+}
+
+// Schema defines the schema for this listing data source.
+func (d *authenticationPortalsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Retrieves config for a specific item.",
+
+		Attributes: map[string]dsschema.Attribute{
+			"id": dsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+			},
+
+			// Input.
+			"object_id": dsschema.StringAttribute{
+				Description:         "The uuid of the resource.",
+				MarkdownDescription: "The uuid of the resource.",
+				Required:            true,
+			},
+			"folder": dsschema.StringAttribute{
+				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
+				},
+			},
+
+			// Output.
+			"authentication_profile": dsschema.StringAttribute{
+				Description:         "The `authentication_profile` parameter.",
+				MarkdownDescription: "The `authentication_profile` parameter.",
+				Computed:            true,
+			},
+			"certificate_profile": dsschema.StringAttribute{
+				Description:         "The `certificate_profile` parameter.",
+				MarkdownDescription: "The `certificate_profile` parameter.",
+				Computed:            true,
+			},
+			"gp_udp_port": dsschema.Int64Attribute{
+				Description:         "The `gp_udp_port` parameter.",
+				MarkdownDescription: "The `gp_udp_port` parameter.",
+				Computed:            true,
+			},
+			"idle_timer": dsschema.Int64Attribute{
+				Description:         "The `idle_timer` parameter.",
+				MarkdownDescription: "The `idle_timer` parameter.",
+				Computed:            true,
+			},
+			"redirect_host": dsschema.StringAttribute{
+				Description:         "The `redirect_host` parameter.",
+				MarkdownDescription: "The `redirect_host` parameter.",
+				Computed:            true,
+			},
+			"timer": dsschema.Int64Attribute{
+				Description:         "The `timer` parameter.",
+				MarkdownDescription: "The `timer` parameter.",
+				Computed:            true,
+			},
+			"tls_service_profile": dsschema.StringAttribute{
+				Description:         "The `tls_service_profile` parameter.",
+				MarkdownDescription: "The `tls_service_profile` parameter.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure prepares the struct.
+func (d *authenticationPortalsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*sase.Client)
+}
+
+func (d *authenticationPortalsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state authenticationPortalsDsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing data source singleton retrieval", map[string]any{
+		"terraform_provider_function": "Read",
+		"data_source_name":            "sase_authentication_portals",
+		"object_id":                   state.ObjectId.ValueString(),
+		"folder":                      state.Folder.ValueString(),
+	})
+
+	// Prepare to run the command.
+	svc := mfYmVgm.NewClient(d.client)
+	input := mfYmVgm.ReadInput{
+		ObjectId: state.ObjectId.ValueString(),
+		Folder:   state.Folder.ValueString(),
+	}
+
+	// Perform the operation.
+	ans, err := svc.Read(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting singleton", err.Error())
+		return
+	}
+
+	// Store the answer to state.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(input.ObjectId)
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(input.Folder)
+	state.Id = types.StringValue(idBuilder.String())
+	state.AuthenticationProfile = types.StringValue(ans.AuthenticationProfile)
+	state.CertificateProfile = types.StringValue(ans.CertificateProfile)
+	state.GpUdpPort = types.Int64Value(ans.GpUdpPort)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.IdleTimer = types.Int64Value(ans.IdleTimer)
+	state.RedirectHost = types.StringValue(ans.RedirectHost)
+	state.Timer = types.Int64Value(ans.Timer)
+	state.TlsServiceProfile = types.StringValue(ans.TlsServiceProfile)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Resource.
+var (
+	_ resource.Resource                = &authenticationPortalsResource{}
+	_ resource.ResourceWithConfigure   = &authenticationPortalsResource{}
+	_ resource.ResourceWithImportState = &authenticationPortalsResource{}
+	_ resource.ResourceWithModifyPlan  = &authenticationPortalsResource{}
+)
+
+func NewAuthenticationPortalsResource() resource.Resource {
+	return &authenticationPortalsResource{}
+}
+
+type authenticationPortalsResource struct {
+	client *sase.Client
+}
+
+type authenticationPortalsRsModel struct {
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
+
+	// Input.
+	Folder types.String `tfsdk:"folder"`
+
+	// Request body input.
+	// Ref: #/components/schemas/authentication-portals
+	AuthenticationProfile types.String `tfsdk:"authentication_profile"`
+	CertificateProfile    types.String `tfsdk:"certificate_profile"`
+	GpUdpPort             types.Int64  `tfsdk:"gp_udp_port"`
+	ObjectId              types.String `tfsdk:"object_id"`
+	IdleTimer             types.Int64  `tfsdk:"idle_timer"`
+	RedirectHost          types.String `tfsdk:"redirect_host"`
+	Timer                 types.Int64  `tfsdk:"timer"`
+	TlsServiceProfile     types.String `tfsdk:"tls_service_profile"`
+}
+
+// Metadata returns the data source type name.
+func (r *authenticationPortalsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authentication_portals"
+}
+
+// Schema defines the schema for this listing data source.
+func (r *authenticationPortalsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rsschema.Schema{
+		Description: "Retrieves config for a specific item.",
+
+		Attributes: map[string]rsschema.Attribute{
+			"id": rsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Input.
+			"folder": rsschema.StringAttribute{
+				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"authentication_profile": rsschema.StringAttribute{
+				Description:         "The `authentication_profile` parameter. The authentication profile must exist in the folder or in a folder that it inherits from, such as `\"Shared\"`, and this is checked at plan time.",
+				MarkdownDescription: "The `authentication_profile` parameter. The authentication profile must exist in the folder or in a folder that it inherits from, such as `\"Shared\"`, and this is checked at plan time.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					DefaultString(""),
+				},
+			},
+			"certificate_profile": rsschema.StringAttribute{
+				Description:         "The `certificate_profile` parameter. The certificate profile must exist in the folder or in a folder that it inherits from, such as `\"Shared\"`, and this is checked at plan time.",
+				MarkdownDescription: "The `certificate_profile` parameter. The certificate profile must exist in the folder or in a folder that it inherits from, such as `\"Shared\"`, and this is checked at plan time.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					DefaultString(""),
+				},
+			},
+			"gp_udp_port": rsschema.Int64Attribute{
+				Description:         "The `gp_udp_port` parameter. Default: `4501`. Value must be between 4501 and 4532.",
+				MarkdownDescription: "The `gp_udp_port` parameter. Default: `4501`. Value must be between 4501 and 4532.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					DefaultInt64(4501),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(4501, 4532),
+				},
+			},
+			"object_id": rsschema.StringAttribute{
+				Description:         "The `object_id` parameter.",
+				MarkdownDescription: "The `object_id` parameter.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"idle_timer": rsschema.Int64Attribute{
+				Description:         "The `idle_timer` parameter. Default: `15`. Value must be between 1 and 1440.",
+				MarkdownDescription: "The `idle_timer` parameter. Default: `15`. Value must be between 1 and 1440.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					DefaultInt64(15),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1440),
+				},
+			},
+			"redirect_host": rsschema.StringAttribute{
+				Description:         "The `redirect_host` parameter. Value must be an IP address or a fully qualified domain name.",
+				MarkdownDescription: "The `redirect_host` parameter. Value must be an IP address or a fully qualified domain name.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					DefaultString(""),
+				},
+				Validators: []validator.String{
+					IsIpAddressOrFqdn(),
+				},
+			},
+			"timer": rsschema.Int64Attribute{
+				Description:         "The `timer` parameter. Default: `60`. Value must be between 1 and 1440.",
+				MarkdownDescription: "The `timer` parameter. Default: `60`. Value must be between 1 and 1440.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					DefaultInt64(60),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1440),
+				},
+			},
+			"tls_service_profile": rsschema.StringAttribute{
+				Description:         "The `tls_service_profile` parameter. The TLS service profile must exist in the folder or in a folder that it inherits from, such as `\"Shared\"`, and this is checked at plan time.",
+				MarkdownDescription: "The `tls_service_profile` parameter. The TLS service profile must exist in the folder or in a folder that it inherits from, such as `\"Shared\"`, and this is checked at plan time.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					DefaultString(""),
+				},
+			},
+		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_authentication_portals"),
+		},
+	}
+}
+
+// Configure prepares the struct.
+func (r *authenticationPortalsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*sase.Client)
+}
+
+// ModifyPlan checks that the profiles that the portal refers to exist, so that
+// a missing profile is reported before anything is applied.
+func (r *authenticationPortalsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	folder := path.Root("folder")
+	CheckPlanReference(ctx, req, resp, r.client, path.Root("authentication_profile"), "authentication profile", folder, AuthenticationProfileExists)
+	CheckPlanReference(ctx, req, resp, r.client, path.Root("certificate_profile"), "certificate profile", folder, CertificateProfileExists)
+	CheckPlanReference(ctx, req, resp, r.client, path.Root("tls_service_profile"), "TLS service profile", folder, TlsServiceProfileExists)
+}
+
+// Create resource
+func (r *authenticationPortalsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state authenticationPortalsRsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_authentication_portals", "create", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource create", map[string]any{
+		"terraform_provider_function": "Create",
+		"resource_name":               "sase_authentication_portals",
+		"folder":                      state.Folder.ValueString(),
+	})
+
+	// Prepare to create the config.
+	svc := mfYmVgm.NewClient(r.client)
+	input := mfYmVgm.CreateInput{
+		Folder: state.Folder.ValueString(),
+	}
+	var var0 hTqWmZc.Config
+	var0.AuthenticationProfile = state.AuthenticationProfile.ValueString()
+	var0.CertificateProfile = state.CertificateProfile.ValueString()
+	var0.GpUdpPort = state.GpUdpPort.ValueInt64()
+	var0.IdleTimer = state.IdleTimer.ValueInt64()
+	var0.RedirectHost = state.RedirectHost.ValueString()
+	var0.Timer = state.Timer.ValueInt64()
+	var0.TlsServiceProfile = state.TlsServiceProfile.ValueString()
+	input.Config = var0
+
+	// Perform the operation.
+	ans, err := svc.Create(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(input.Folder)
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(ans.ObjectId)
+	state.Id = types.StringValue(idBuilder.String())
+	state.AuthenticationProfile = types.StringValue(ans.AuthenticationProfile)
+	state.CertificateProfile = types.StringValue(ans.CertificateProfile)
+	state.GpUdpPort = types.Int64Value(ans.GpUdpPort)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.IdleTimer = types.Int64Value(ans.IdleTimer)
+	state.RedirectHost = types.StringValue(ans.RedirectHost)
+	state.Timer = types.Int64Value(ans.Timer)
+	state.TlsServiceProfile = types.StringValue(ans.TlsServiceProfile)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read resource.
+func (r *authenticationPortalsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var idType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &idType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := idType.ValueString()
+	tokens := strings.Split(id, IdSeparator)
+	if len(tokens) != 2 {
+		resp.Diagnostics.AddError("Error in resource ID format", "Expected 2 tokens")
+		return
+	}
+
+	var state authenticationPortalsRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_authentication_portals", "read", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource read", map[string]any{
+		"terraform_provider_function": "Read",
+		"resource_name":               "sase_authentication_portals",
+		"locMap":                      map[string]int{"Folder": 0, "ObjectId": 1},
+		"tokens":                      tokens,
+	})
+
+	// Prepare to read the config.
+	svc := mfYmVgm.NewClient(r.client)
+	input := mfYmVgm.ReadInput{
+		ObjectId: tokens[1],
+		Folder:   tokens[0],
+	}
+
+	// Perform the operation.
+	ans, err := svc.Read(ctx, input)
+	if err != nil {
+		if IsObjectNotFound(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
+		}
+		return
+	}
+
+	// Store the answer to state.
+	state.Folder = types.StringValue(tokens[0])
+	state.Id = idType
+	state.AuthenticationProfile = types.StringValue(ans.AuthenticationProfile)
+	state.CertificateProfile = types.StringValue(ans.CertificateProfile)
+	state.GpUdpPort = types.Int64Value(ans.GpUdpPort)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.IdleTimer = types.Int64Value(ans.IdleTimer)
+	state.RedirectHost = types.StringValue(ans.RedirectHost)
+	state.Timer = types.Int64Value(ans.Timer)
+	state.TlsServiceProfile = types.StringValue(ans.TlsServiceProfile)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update resource.
+func (r *authenticationPortalsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state authenticationPortalsRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_authentication_portals", "update", plan.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource update", map[string]any{
+		"terraform_provider_function": "Update",
+		"resource_name":               "sase_authentication_portals",
+		"object_id":                   state.ObjectId.ValueString(),
+	})
+
+	// Prepare to create the config.
+	svc := mfYmVgm.NewClient(r.client)
+	input := mfYmVgm.UpdateInput{
+		ObjectId: state.ObjectId.ValueString(),
+	}
+	var var0 hTqWmZc.Config
+	var0.AuthenticationProfile = plan.AuthenticationProfile.ValueString()
+	var0.CertificateProfile = plan.CertificateProfile.ValueString()
+	var0.GpUdpPort = plan.GpUdpPort.ValueInt64()
+	var0.IdleTimer = plan.IdleTimer.ValueInt64()
+	var0.RedirectHost = plan.RedirectHost.ValueString()
+	var0.Timer = plan.Timer.ValueInt64()
+	var0.TlsServiceProfile = plan.TlsServiceProfile.ValueString()
+	input.Config = var0
+
+	// Perform the operation.
+	ans, err := svc.Update(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
+	state.AuthenticationProfile = types.StringValue(ans.AuthenticationProfile)
+	state.CertificateProfile = types.StringValue(ans.CertificateProfile)
+	state.GpUdpPort = types.Int64Value(ans.GpUdpPort)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.IdleTimer = types.Int64Value(ans.IdleTimer)
+	state.RedirectHost = types.StringValue(ans.RedirectHost)
+	state.Timer = types.Int64Value(ans.Timer)
+	state.TlsServiceProfile = types.StringValue(ans.TlsServiceProfile)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete resource.
+func (r *authenticationPortalsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var idType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &idType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_authentication_portals", "delete", timeouts)
+	defer cancel()
+
+	id := idType.ValueString()
+	tokens := strings.Split(id, IdSeparator)
+	if len(tokens) != 2 {
+		resp.Diagnostics.AddError("Error in resource ID format", "Expected 2 tokens")
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource delete", map[string]any{
+		"terraform_provider_function": "Delete",
+		"resource_name":               "sase_authentication_portals",
+		"locMap":                      map[string]int{"Folder": 0, "ObjectId": 1},
+		"tokens":                      tokens,
+	})
+
+	svc := mfYmVgm.NewClient(r.client)
+	input := mfYmVgm.DeleteInput{
+		ObjectId: tokens[1],
+	}
+
+	// Perform the operation.
+	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
+	}
+}
+
+// ImportState imports by the resource ID, which is "folder:object_id".
+// Portals have no name to look them up by.
+func (r *authenticationPortalsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
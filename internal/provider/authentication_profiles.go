@@ -42,10 +42,13 @@ type authenticationProfilesListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Folder types.String `tfsdk:"folder"`
-	Name   types.String `tfsdk:"name"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Folder   types.String `tfsdk:"folder"`
+	Name     types.String `tfsdk:"name"`
 
 	// Output.
 	Data []authenticationProfilesListDsModelConfig `tfsdk:"data"`
@@ -150,6 +153,27 @@ func (d *authenticationProfilesListDataSource) Schema(_ context.Context, _ datas
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"folder": dsschema.StringAttribute{
 				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
 				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
@@ -353,6 +377,7 @@ func (d *authenticationProfilesListDataSource) Schema(_ context.Context, _ datas
 									Description:         "The `kerberos_keytab` parameter.",
 									MarkdownDescription: "The `kerberos_keytab` parameter.",
 									Computed:            true,
+									Sensitive:           true,
 								},
 								"realm": dsschema.StringAttribute{
 									Description:         "The `realm` parameter.",
@@ -427,12 +452,32 @@ func (d *authenticationProfilesListDataSource) Read(ctx context.Context, req dat
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -848,6 +893,7 @@ func (d *authenticationProfilesDataSource) Schema(_ context.Context, _ datasourc
 						Description:         "The `kerberos_keytab` parameter.",
 						MarkdownDescription: "The `kerberos_keytab` parameter.",
 						Computed:            true,
+						Sensitive:           true,
 					},
 					"realm": dsschema.StringAttribute{
 						Description:         "The `realm` parameter.",
@@ -995,9 +1041,10 @@ func (d *authenticationProfilesDataSource) Read(ctx context.Context, req datasou
 
 // Resource.
 var (
-	_ resource.Resource                = &authenticationProfilesResource{}
-	_ resource.ResourceWithConfigure   = &authenticationProfilesResource{}
-	_ resource.ResourceWithImportState = &authenticationProfilesResource{}
+	_ resource.Resource                     = &authenticationProfilesResource{}
+	_ resource.ResourceWithConfigure        = &authenticationProfilesResource{}
+	_ resource.ResourceWithConfigValidators = &authenticationProfilesResource{}
+	_ resource.ResourceWithImportState      = &authenticationProfilesResource{}
 )
 
 func NewAuthenticationProfilesResource() resource.Resource {
@@ -1009,7 +1056,8 @@ type authenticationProfilesResource struct {
 }
 
 type authenticationProfilesRsModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
 
 	// Input.
 	Folder types.String `tfsdk:"folder"`
@@ -1109,6 +1157,9 @@ func (r *authenticationProfilesResource) Schema(_ context.Context, _ resource.Sc
 				Validators: []validator.String{
 					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 
 			"allow_list": rsschema.ListAttribute{
@@ -1390,6 +1441,7 @@ func (r *authenticationProfilesResource) Schema(_ context.Context, _ resource.Sc
 						MarkdownDescription: "The `kerberos_keytab` parameter. String length must be at most 8192.",
 						Optional:            true,
 						Computed:            true,
+						Sensitive:           true,
 						PlanModifiers: []planmodifier.String{
 							DefaultString(""),
 						},
@@ -1436,6 +1488,24 @@ func (r *authenticationProfilesResource) Schema(_ context.Context, _ resource.Sc
 				},
 			},
 		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_authentication_profiles"),
+		},
+	}
+}
+
+// ConfigValidators returns the checks for mutually exclusive attributes.
+func (r *authenticationProfilesResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		ExactlyOneOfNested(
+			path.MatchRoot("method"),
+			"kerberos",
+			"ldap",
+			"local_database",
+			"radius",
+			"saml_idp",
+			"tacplus",
+		),
 	}
 }
 
@@ -1456,6 +1526,9 @@ func (r *authenticationProfilesResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_authentication_profiles", "create", state.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource create", map[string]any{
 		"terraform_provider_function": "Create",
@@ -1547,7 +1620,7 @@ func (r *authenticationProfilesResource) Create(ctx context.Context, req resourc
 	// Perform the operation.
 	ans, err := svc.Create(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in create", err.Error())
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
 		return
 	}
 
@@ -1651,6 +1724,13 @@ func (r *authenticationProfilesResource) Read(ctx context.Context, req resource.
 	}
 
 	var state authenticationProfilesRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_authentication_profiles", "read", state.Timeouts)
+	defer cancel()
 
 	// Basic logging.
 	tflog.Info(ctx, "performing resource read", map[string]any{
@@ -1673,7 +1753,7 @@ func (r *authenticationProfilesResource) Read(ctx context.Context, req resource.
 		if IsObjectNotFound(err) {
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error reading config", err.Error())
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
 		}
 		return
 	}
@@ -1773,6 +1853,9 @@ func (r *authenticationProfilesResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_authentication_profiles", "update", plan.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource update", map[string]any{
 		"terraform_provider_function": "Update",
@@ -1864,11 +1947,12 @@ func (r *authenticationProfilesResource) Update(ctx context.Context, req resourc
 	// Perform the operation.
 	ans, err := svc.Update(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in update", err.Error())
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
 		return
 	}
 
 	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
 	var var10 *authenticationProfilesRsModelLockoutObject
 	if ans.Lockout != nil {
 		var10 = &authenticationProfilesRsModelLockoutObject{}
@@ -1955,6 +2039,16 @@ func (r *authenticationProfilesResource) Delete(ctx context.Context, req resourc
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_authentication_profiles", "delete", timeouts)
+	defer cancel()
+
 	id := idType.ValueString()
 	tokens := strings.Split(id, IdSeparator)
 	if len(tokens) != 2 {
@@ -1977,10 +2071,78 @@ func (r *authenticationProfilesResource) Delete(ctx context.Context, req resourc
 
 	// Perform the operation.
 	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
-		resp.Diagnostics.AddError("Error in delete", err.Error())
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
 	}
 }
 
+// ImportState imports by either the resource ID or by location params and name,
+// such as `folder=...,name=...`.
 func (r *authenticationProfilesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	params, ok, err := ParseImportId(req.ID, "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_authentication_profiles",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := cUCsSiw.NewClient(r.client)
+	input := cUCsSiw.ListInput{
+		Folder: params["folder"],
+		Name:   api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
+}
+
+// AuthenticationProfileExists is a ReferenceLookup for authentication profiles.
+func AuthenticationProfileExists(ctx context.Context, client *sase.Client, folder, name string) (bool, error) {
+	svc := cUCsSiw.NewClient(client)
+	ans, err := svc.List(ctx, cUCsSiw.ListInput{
+		Folder: folder,
+		Name:   api.String(name),
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, x := range ans.Data {
+		if x.Name == name {
+			return true, nil
+		}
+	}
+
+	return false, nil
 }
@@ -7,11 +7,18 @@ import (
 
 	"github.com/paloaltonetworks/sase-go"
 	"github.com/paloaltonetworks/sase-go/api"
+	tGmRbKs "github.com/paloaltonetworks/sase-go/netsec/schema/authentication/rules"
 	zDUyfEt "github.com/paloaltonetworks/sase-go/netsec/service/v1/authenticationrules"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rsschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -37,6 +44,9 @@ type authenticationRulesListDsModel struct {
 	// Input.
 	Limit    types.Int64  `tfsdk:"limit"`
 	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
 	Position types.String `tfsdk:"position"`
 	Folder   types.String `tfsdk:"folder"`
 	Name     types.String `tfsdk:"name"`
@@ -103,6 +113,27 @@ func (d *authenticationRulesListDataSource) Schema(_ context.Context, _ datasour
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"position": dsschema.StringAttribute{
 				Description:         "The position of a security rule. Value must be one of: `\"pre\"`, `\"post\"`.",
 				MarkdownDescription: "The position of a security rule. Value must be one of: `\"pre\"`, `\"post\"`.",
@@ -311,12 +342,32 @@ func (d *authenticationRulesListDataSource) Read(ctx context.Context, req dataso
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -383,3 +434,972 @@ func (d *authenticationRulesListDataSource) Read(ctx context.Context, req dataso
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// Data source.
+var (
+	_ datasource.DataSource              = &authenticationRulesDataSource{}
+	_ datasource.DataSourceWithConfigure = &authenticationRulesDataSource{}
+)
+
+func NewAuthenticationRulesDataSource() datasource.DataSource {
+	return &authenticationRulesDataSource{}
+}
+
+type authenticationRulesDataSource struct {
+	client *sase.Client
+}
+
+type authenticationRulesDsModel struct {
+	Id types.String `tfsdk:"id"`
+
+	// Input.
+	ObjectId types.String `tfsdk:"object_id"`
+	Folder   types.String `tfsdk:"folder"`
+
+	// Output.
+	// Ref: #/components/schemas/authentication-rules
+	AuthenticationEnforcement types.String   `tfsdk:"authentication_enforcement"`
+	Category                  []types.String `tfsdk:"category"`
+	Description               types.String   `tfsdk:"description"`
+	Destination               []types.String `tfsdk:"destination"`
+	DestinationHip            []types.String `tfsdk:"destination_hip"`
+	Disabled                  types.Bool     `tfsdk:"disabled"`
+	From                      []types.String `tfsdk:"from"`
+	GroupTag                  types.String   `tfsdk:"group_tag"`
+	HipProfiles               []types.String `tfsdk:"hip_profiles"`
+	// input omit: ObjectId
+	LogAuthenticationTimeout types.Bool     `tfsdk:"log_authentication_timeout"`
+	LogSetting               types.String   `tfsdk:"log_setting"`
+	Name                     types.String   `tfsdk:"name"`
+	NegateDestination        types.Bool     `tfsdk:"negate_destination"`
+	NegateSource             types.Bool     `tfsdk:"negate_source"`
+	Service                  []types.String `tfsdk:"service"`
+	Source                   []types.String `tfsdk:"source"`
+	SourceHip                []types.String `tfsdk:"source_hip"`
+	SourceUser               []types.String `tfsdk:"source_user"`
+	Tag                      []types.String `tfsdk:"tag"`
+	Timeout                  types.Int64    `tfsdk:"timeout"`
+	To                       []types.String `tfsdk:"to"`
+}
+
+// Metadata returns the data source type name.
+func (d *authenticationRulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authentication_rules"
+}
+
+// Schema defines the schema for this listing data source.
+func (d *authenticationRulesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Retrieves config for a specific item.",
+
+		Attributes: map[string]dsschema.Attribute{
+			"id": dsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+			},
+
+			// Input.
+			"object_id": dsschema.StringAttribute{
+				Description:         "The uuid of the resource.",
+				MarkdownDescription: "The uuid of the resource.",
+				Required:            true,
+			},
+			"folder": dsschema.StringAttribute{
+				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
+				},
+			},
+
+			// Output.
+			"authentication_enforcement": dsschema.StringAttribute{
+				Description:         "The `authentication_enforcement` parameter.",
+				MarkdownDescription: "The `authentication_enforcement` parameter.",
+				Computed:            true,
+			},
+			"category": dsschema.ListAttribute{
+				Description:         "The `category` parameter.",
+				MarkdownDescription: "The `category` parameter.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"description": dsschema.StringAttribute{
+				Description:         "The `description` parameter.",
+				MarkdownDescription: "The `description` parameter.",
+				Computed:            true,
+			},
+			"destination": dsschema.ListAttribute{
+				Description:         "The `destination` parameter.",
+				MarkdownDescription: "The `destination` parameter.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"destination_hip": dsschema.ListAttribute{
+				Description:         "The `destination_hip` parameter.",
+				MarkdownDescription: "The `destination_hip` parameter.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"disabled": dsschema.BoolAttribute{
+				Description:         "The `disabled` parameter.",
+				MarkdownDescription: "The `disabled` parameter.",
+				Computed:            true,
+			},
+			"from": dsschema.ListAttribute{
+				Description:         "The `from` parameter.",
+				MarkdownDescription: "The `from` parameter.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"group_tag": dsschema.StringAttribute{
+				Description:         "The `group_tag` parameter.",
+				MarkdownDescription: "The `group_tag` parameter.",
+				Computed:            true,
+			},
+			"hip_profiles": dsschema.ListAttribute{
+				Description:         "The `hip_profiles` parameter.",
+				MarkdownDescription: "The `hip_profiles` parameter.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"log_authentication_timeout": dsschema.BoolAttribute{
+				Description:         "The `log_authentication_timeout` parameter.",
+				MarkdownDescription: "The `log_authentication_timeout` parameter.",
+				Computed:            true,
+			},
+			"log_setting": dsschema.StringAttribute{
+				Description:         "The `log_setting` parameter.",
+				MarkdownDescription: "The `log_setting` parameter.",
+				Computed:            true,
+			},
+			"name": dsschema.StringAttribute{
+				Description:         "The `name` parameter.",
+				MarkdownDescription: "The `name` parameter.",
+				Computed:            true,
+			},
+			"negate_destination": dsschema.BoolAttribute{
+				Description:         "The `negate_destination` parameter.",
+				MarkdownDescription: "The `negate_destination` parameter.",
+				Computed:            true,
+			},
+			"negate_source": dsschema.BoolAttribute{
+				Description:         "The `negate_source` parameter.",
+				MarkdownDescription: "The `negate_source` parameter.",
+				Computed:            true,
+			},
+			"service": dsschema.ListAttribute{
+				Description:         "The `service` parameter.",
+				MarkdownDescription: "The `service` parameter.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"source": dsschema.ListAttribute{
+				Description:         "The `source` parameter.",
+				MarkdownDescription: "The `source` parameter.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"source_hip": dsschema.ListAttribute{
+				Description:         "The `source_hip` parameter.",
+				MarkdownDescription: "The `source_hip` parameter.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"source_user": dsschema.ListAttribute{
+				Description:         "The `source_user` parameter.",
+				MarkdownDescription: "The `source_user` parameter.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"tag": dsschema.ListAttribute{
+				Description:         "The `tag` parameter.",
+				MarkdownDescription: "The `tag` parameter.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"timeout": dsschema.Int64Attribute{
+				Description:         "The `timeout` parameter.",
+				MarkdownDescription: "The `timeout` parameter.",
+				Computed:            true,
+			},
+			"to": dsschema.ListAttribute{
+				Description:         "The `to` parameter.",
+				MarkdownDescription: "The `to` parameter.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+// Configure prepares the struct.
+func (d *authenticationRulesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*sase.Client)
+}
+
+func (d *authenticationRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state authenticationRulesDsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing data source singleton retrieval", map[string]any{
+		"terraform_provider_function": "Read",
+		"data_source_name":            "sase_authentication_rules",
+		"object_id":                   state.ObjectId.ValueString(),
+		"folder":                      state.Folder.ValueString(),
+	})
+
+	// Prepare to run the command.
+	svc := zDUyfEt.NewClient(d.client)
+	input := zDUyfEt.ReadInput{
+		ObjectId: state.ObjectId.ValueString(),
+		Folder:   state.Folder.ValueString(),
+	}
+
+	// Perform the operation.
+	ans, err := svc.Read(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting singleton", err.Error())
+		return
+	}
+
+	// Store the answer to state.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(input.ObjectId)
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(input.Folder)
+	state.Id = types.StringValue(idBuilder.String())
+	state.AuthenticationEnforcement = types.StringValue(ans.AuthenticationEnforcement)
+	state.Category = EncodeStringSlice(ans.Category)
+	state.Description = types.StringValue(ans.Description)
+	state.Destination = EncodeStringSlice(ans.Destination)
+	state.DestinationHip = EncodeStringSlice(ans.DestinationHip)
+	state.Disabled = types.BoolValue(ans.Disabled)
+	state.From = EncodeStringSlice(ans.From)
+	state.GroupTag = types.StringValue(ans.GroupTag)
+	state.HipProfiles = EncodeStringSlice(ans.HipProfiles)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.LogAuthenticationTimeout = types.BoolValue(ans.LogAuthenticationTimeout)
+	state.LogSetting = types.StringValue(ans.LogSetting)
+	state.Name = types.StringValue(ans.Name)
+	state.NegateDestination = types.BoolValue(ans.NegateDestination)
+	state.NegateSource = types.BoolValue(ans.NegateSource)
+	state.Service = EncodeStringSlice(ans.Service)
+	state.Source = EncodeStringSlice(ans.Source)
+	state.SourceHip = EncodeStringSlice(ans.SourceHip)
+	state.SourceUser = EncodeStringSlice(ans.SourceUser)
+	state.Tag = EncodeStringSlice(ans.Tag)
+	state.Timeout = types.Int64Value(ans.Timeout)
+	state.To = EncodeStringSlice(ans.To)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Resource.
+var (
+	_ resource.Resource                     = &authenticationRulesResource{}
+	_ resource.ResourceWithConfigure        = &authenticationRulesResource{}
+	_ resource.ResourceWithImportState      = &authenticationRulesResource{}
+	_ resource.ResourceWithConfigValidators = &authenticationRulesResource{}
+)
+
+func NewAuthenticationRulesResource() resource.Resource {
+	return &authenticationRulesResource{}
+}
+
+type authenticationRulesResource struct {
+	client *sase.Client
+}
+
+type authenticationRulesRsModel struct {
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
+
+	// Input.
+	Position         types.String `tfsdk:"position"`
+	Folder           types.String `tfsdk:"folder"`
+	RelativePosition types.String `tfsdk:"relative_position"`
+	TargetRule       types.String `tfsdk:"target_rule"`
+
+	// Request body input.
+	// Ref: #/components/schemas/authentication-rules
+	AuthenticationEnforcement types.String   `tfsdk:"authentication_enforcement"`
+	Category                  []types.String `tfsdk:"category"`
+	Description               types.String   `tfsdk:"description"`
+	Destination               []types.String `tfsdk:"destination"`
+	DestinationHip            []types.String `tfsdk:"destination_hip"`
+	Disabled                  types.Bool     `tfsdk:"disabled"`
+	From                      []types.String `tfsdk:"from"`
+	GroupTag                  types.String   `tfsdk:"group_tag"`
+	HipProfiles               []types.String `tfsdk:"hip_profiles"`
+	ObjectId                  types.String   `tfsdk:"object_id"`
+	LogAuthenticationTimeout  types.Bool     `tfsdk:"log_authentication_timeout"`
+	LogSetting                types.String   `tfsdk:"log_setting"`
+	Name                      types.String   `tfsdk:"name"`
+	NegateDestination         types.Bool     `tfsdk:"negate_destination"`
+	NegateSource              types.Bool     `tfsdk:"negate_source"`
+	Service                   []types.String `tfsdk:"service"`
+	Source                    []types.String `tfsdk:"source"`
+	SourceHip                 []types.String `tfsdk:"source_hip"`
+	SourceUser                []types.String `tfsdk:"source_user"`
+	Tag                       []types.String `tfsdk:"tag"`
+	Timeout                   types.Int64    `tfsdk:"timeout"`
+	To                        []types.String `tfsdk:"to"`
+}
+
+// Metadata returns the data source type name.
+func (r *authenticationRulesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authentication_rules"
+}
+
+// Schema defines the schema for this listing data source.
+func (r *authenticationRulesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rsschema.Schema{
+		Description: "Retrieves config for a specific item.",
+
+		Attributes: map[string]rsschema.Attribute{
+			"id": rsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Input.
+			"position": rsschema.StringAttribute{
+				Description:         "The position of a security rule. Value must be one of: `\"pre\"`, `\"post\"`.",
+				MarkdownDescription: "The position of a security rule. Value must be one of: `\"pre\"`, `\"post\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("pre", "post"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"folder": rsschema.StringAttribute{
+				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"relative_position": rsschema.StringAttribute{
+				Description:         "Where to move the rule within its rulebase when it is created or updated. If unset, the rule is not moved. Value must be one of: `\"top\"`, `\"bottom\"`, `\"before\"`, `\"after\"`.",
+				MarkdownDescription: "Where to move the rule within its rulebase when it is created or updated. If unset, the rule is not moved. Value must be one of: `\"top\"`, `\"bottom\"`, `\"before\"`, `\"after\"`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(RuleDestinations...),
+				},
+			},
+			"target_rule": rsschema.StringAttribute{
+				Description:         "The object ID of the rule to move this rule before or after. Required if `relative_position` is `\"before\"` or `\"after\"`.",
+				MarkdownDescription: "The object ID of the rule to move this rule before or after. Required if `relative_position` is `\"before\"` or `\"after\"`.",
+				Optional:            true,
+			},
+
+			"authentication_enforcement": rsschema.StringAttribute{
+				Description:         "The `authentication_enforcement` parameter.",
+				MarkdownDescription: "The `authentication_enforcement` parameter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					DefaultString(""),
+				},
+			},
+			"category": rsschema.ListAttribute{
+				Description:         "The `category` parameter.",
+				MarkdownDescription: "The `category` parameter.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"description": rsschema.StringAttribute{
+				Description:         "The `description` parameter. String length must be at most 1024.",
+				MarkdownDescription: "The `description` parameter. String length must be at most 1024.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					DefaultString(""),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			"destination": rsschema.ListAttribute{
+				Description:         "The `destination` parameter.",
+				MarkdownDescription: "The `destination` parameter.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"destination_hip": rsschema.ListAttribute{
+				Description:         "The `destination_hip` parameter.",
+				MarkdownDescription: "The `destination_hip` parameter.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"disabled": rsschema.BoolAttribute{
+				Description:         "The `disabled` parameter.",
+				MarkdownDescription: "The `disabled` parameter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					DefaultBool(false),
+				},
+			},
+			"from": rsschema.ListAttribute{
+				Description:         "The `from` parameter.",
+				MarkdownDescription: "The `from` parameter.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"group_tag": rsschema.StringAttribute{
+				Description:         "The `group_tag` parameter.",
+				MarkdownDescription: "The `group_tag` parameter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					DefaultString(""),
+				},
+			},
+			"hip_profiles": rsschema.ListAttribute{
+				Description:         "The `hip_profiles` parameter.",
+				MarkdownDescription: "The `hip_profiles` parameter.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"object_id": rsschema.StringAttribute{
+				Description:         "The `object_id` parameter.",
+				MarkdownDescription: "The `object_id` parameter.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"log_authentication_timeout": rsschema.BoolAttribute{
+				Description:         "The `log_authentication_timeout` parameter.",
+				MarkdownDescription: "The `log_authentication_timeout` parameter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					DefaultBool(false),
+				},
+			},
+			"log_setting": rsschema.StringAttribute{
+				Description:         "The `log_setting` parameter.",
+				MarkdownDescription: "The `log_setting` parameter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					DefaultString(""),
+				},
+			},
+			"name": rsschema.StringAttribute{
+				Description:         "The `name` parameter. String length must be at most 63.",
+				MarkdownDescription: "The `name` parameter. String length must be at most 63.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(63),
+				},
+			},
+			"negate_destination": rsschema.BoolAttribute{
+				Description:         "The `negate_destination` parameter.",
+				MarkdownDescription: "The `negate_destination` parameter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					DefaultBool(false),
+				},
+			},
+			"negate_source": rsschema.BoolAttribute{
+				Description:         "The `negate_source` parameter.",
+				MarkdownDescription: "The `negate_source` parameter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					DefaultBool(false),
+				},
+			},
+			"service": rsschema.ListAttribute{
+				Description:         "The `service` parameter.",
+				MarkdownDescription: "The `service` parameter.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"source": rsschema.ListAttribute{
+				Description:         "The `source` parameter.",
+				MarkdownDescription: "The `source` parameter.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"source_hip": rsschema.ListAttribute{
+				Description:         "The `source_hip` parameter.",
+				MarkdownDescription: "The `source_hip` parameter.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"source_user": rsschema.ListAttribute{
+				Description:         "The `source_user` parameter.",
+				MarkdownDescription: "The `source_user` parameter.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"tag": rsschema.ListAttribute{
+				Description:         "The `tag` parameter.",
+				MarkdownDescription: "The `tag` parameter.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"timeout": rsschema.Int64Attribute{
+				Description:         "The `timeout` parameter. Default: `60`. Value must be between 1 and 1440.",
+				MarkdownDescription: "The `timeout` parameter. Default: `60`. Value must be between 1 and 1440.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					DefaultInt64(60),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1440),
+				},
+			},
+			"to": rsschema.ListAttribute{
+				Description:         "The `to` parameter.",
+				MarkdownDescription: "The `to` parameter.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_authentication_rules"),
+		},
+	}
+}
+
+// ConfigValidators returns the checks for the rule move attributes.
+func (r *authenticationRulesResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		RuleMoveValidator(path.Root("relative_position"), path.Root("target_rule")),
+	}
+}
+
+// Configure prepares the struct.
+func (r *authenticationRulesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*sase.Client)
+}
+
+// Create resource
+func (r *authenticationRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state authenticationRulesRsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_authentication_rules", "create", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource create", map[string]any{
+		"terraform_provider_function": "Create",
+		"resource_name":               "sase_authentication_rules",
+		"position":                    state.Position.ValueString(),
+		"folder":                      state.Folder.ValueString(),
+	})
+
+	// Prepare to create the config.
+	svc := zDUyfEt.NewClient(r.client)
+	input := zDUyfEt.CreateInput{
+		Position: state.Position.ValueString(),
+		Folder:   state.Folder.ValueString(),
+	}
+	var var0 tGmRbKs.Config
+	var0.AuthenticationEnforcement = state.AuthenticationEnforcement.ValueString()
+	var0.Category = DecodeStringSlice(state.Category)
+	var0.Description = state.Description.ValueString()
+	var0.Destination = DecodeStringSlice(state.Destination)
+	var0.DestinationHip = DecodeStringSlice(state.DestinationHip)
+	var0.Disabled = state.Disabled.ValueBool()
+	var0.From = DecodeStringSlice(state.From)
+	var0.GroupTag = state.GroupTag.ValueString()
+	var0.HipProfiles = DecodeStringSlice(state.HipProfiles)
+	var0.LogAuthenticationTimeout = state.LogAuthenticationTimeout.ValueBool()
+	var0.LogSetting = state.LogSetting.ValueString()
+	var0.Name = state.Name.ValueString()
+	var0.NegateDestination = state.NegateDestination.ValueBool()
+	var0.NegateSource = state.NegateSource.ValueBool()
+	var0.Service = DecodeStringSlice(state.Service)
+	var0.Source = DecodeStringSlice(state.Source)
+	var0.SourceHip = DecodeStringSlice(state.SourceHip)
+	var0.SourceUser = DecodeStringSlice(state.SourceUser)
+	var0.Tag = DecodeStringSlice(state.Tag)
+	var0.Timeout = state.Timeout.ValueInt64()
+	var0.To = DecodeStringSlice(state.To)
+	input.Config = var0
+
+	// Perform the operation.
+	ans, err := svc.Create(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(input.Position)
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(input.Folder)
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(ans.ObjectId)
+	state.Id = types.StringValue(idBuilder.String())
+	state.AuthenticationEnforcement = types.StringValue(ans.AuthenticationEnforcement)
+	state.Category = EncodeStringSlice(ans.Category)
+	state.Description = types.StringValue(ans.Description)
+	state.Destination = EncodeStringSlice(ans.Destination)
+	state.DestinationHip = EncodeStringSlice(ans.DestinationHip)
+	state.Disabled = types.BoolValue(ans.Disabled)
+	state.From = EncodeStringSlice(ans.From)
+	state.GroupTag = types.StringValue(ans.GroupTag)
+	state.HipProfiles = EncodeStringSlice(ans.HipProfiles)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.LogAuthenticationTimeout = types.BoolValue(ans.LogAuthenticationTimeout)
+	state.LogSetting = types.StringValue(ans.LogSetting)
+	state.Name = types.StringValue(ans.Name)
+	state.NegateDestination = types.BoolValue(ans.NegateDestination)
+	state.NegateSource = types.BoolValue(ans.NegateSource)
+	state.Service = EncodeStringSlice(ans.Service)
+	state.Source = EncodeStringSlice(ans.Source)
+	state.SourceHip = EncodeStringSlice(ans.SourceHip)
+	state.SourceUser = EncodeStringSlice(ans.SourceUser)
+	state.Tag = EncodeStringSlice(ans.Tag)
+	state.Timeout = types.Int64Value(ans.Timeout)
+	state.To = EncodeStringSlice(ans.To)
+
+	// Move the rule.  The rule exists now, so it is saved to state even if
+	// the move fails.
+	if !state.RelativePosition.IsNull() {
+		moveInput := zDUyfEt.MoveInput{
+			ObjectId:        ans.ObjectId,
+			Rulebase:        state.Position.ValueString(),
+			Destination:     state.RelativePosition.ValueString(),
+			DestinationRule: state.TargetRule.ValueString(),
+		}
+		if err = svc.Move(ctx, moveInput); err != nil {
+			resp.Diagnostics.AddError("Error moving rule", OperationError(ctx, err))
+		}
+	}
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read resource.
+func (r *authenticationRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var idType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &idType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := idType.ValueString()
+	tokens := strings.Split(id, IdSeparator)
+	if len(tokens) != 3 {
+		resp.Diagnostics.AddError("Error in resource ID format", "Expected 3 tokens")
+		return
+	}
+
+	var state authenticationRulesRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("relative_position"), &state.RelativePosition)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("target_rule"), &state.TargetRule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_authentication_rules", "read", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource read", map[string]any{
+		"terraform_provider_function": "Read",
+		"resource_name":               "sase_authentication_rules",
+		"locMap":                      map[string]int{"Folder": 1, "ObjectId": 2, "Position": 0},
+		"tokens":                      tokens,
+	})
+
+	// Prepare to read the config.
+	svc := zDUyfEt.NewClient(r.client)
+	input := zDUyfEt.ReadInput{
+		ObjectId: tokens[2],
+		Folder:   tokens[1],
+	}
+
+	// Perform the operation.
+	ans, err := svc.Read(ctx, input)
+	if err != nil {
+		if IsObjectNotFound(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
+		}
+		return
+	}
+
+	// Store the answer to state.
+	state.Position = types.StringValue(tokens[0])
+	state.Folder = types.StringValue(tokens[1])
+	state.Id = idType
+	state.AuthenticationEnforcement = types.StringValue(ans.AuthenticationEnforcement)
+	state.Category = EncodeStringSlice(ans.Category)
+	state.Description = types.StringValue(ans.Description)
+	state.Destination = EncodeStringSlice(ans.Destination)
+	state.DestinationHip = EncodeStringSlice(ans.DestinationHip)
+	state.Disabled = types.BoolValue(ans.Disabled)
+	state.From = EncodeStringSlice(ans.From)
+	state.GroupTag = types.StringValue(ans.GroupTag)
+	state.HipProfiles = EncodeStringSlice(ans.HipProfiles)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.LogAuthenticationTimeout = types.BoolValue(ans.LogAuthenticationTimeout)
+	state.LogSetting = types.StringValue(ans.LogSetting)
+	state.Name = types.StringValue(ans.Name)
+	state.NegateDestination = types.BoolValue(ans.NegateDestination)
+	state.NegateSource = types.BoolValue(ans.NegateSource)
+	state.Service = EncodeStringSlice(ans.Service)
+	state.Source = EncodeStringSlice(ans.Source)
+	state.SourceHip = EncodeStringSlice(ans.SourceHip)
+	state.SourceUser = EncodeStringSlice(ans.SourceUser)
+	state.Tag = EncodeStringSlice(ans.Tag)
+	state.Timeout = types.Int64Value(ans.Timeout)
+	state.To = EncodeStringSlice(ans.To)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update resource.
+func (r *authenticationRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state authenticationRulesRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_authentication_rules", "update", plan.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource update", map[string]any{
+		"terraform_provider_function": "Update",
+		"resource_name":               "sase_authentication_rules",
+		"object_id":                   state.ObjectId.ValueString(),
+	})
+
+	// Prepare to create the config.
+	svc := zDUyfEt.NewClient(r.client)
+	input := zDUyfEt.UpdateInput{
+		ObjectId: state.ObjectId.ValueString(),
+	}
+	var var0 tGmRbKs.Config
+	var0.AuthenticationEnforcement = plan.AuthenticationEnforcement.ValueString()
+	var0.Category = DecodeStringSlice(plan.Category)
+	var0.Description = plan.Description.ValueString()
+	var0.Destination = DecodeStringSlice(plan.Destination)
+	var0.DestinationHip = DecodeStringSlice(plan.DestinationHip)
+	var0.Disabled = plan.Disabled.ValueBool()
+	var0.From = DecodeStringSlice(plan.From)
+	var0.GroupTag = plan.GroupTag.ValueString()
+	var0.HipProfiles = DecodeStringSlice(plan.HipProfiles)
+	var0.LogAuthenticationTimeout = plan.LogAuthenticationTimeout.ValueBool()
+	var0.LogSetting = plan.LogSetting.ValueString()
+	var0.Name = plan.Name.ValueString()
+	var0.NegateDestination = plan.NegateDestination.ValueBool()
+	var0.NegateSource = plan.NegateSource.ValueBool()
+	var0.Service = DecodeStringSlice(plan.Service)
+	var0.Source = DecodeStringSlice(plan.Source)
+	var0.SourceHip = DecodeStringSlice(plan.SourceHip)
+	var0.SourceUser = DecodeStringSlice(plan.SourceUser)
+	var0.Tag = DecodeStringSlice(plan.Tag)
+	var0.Timeout = plan.Timeout.ValueInt64()
+	var0.To = DecodeStringSlice(plan.To)
+	input.Config = var0
+
+	// Perform the operation.
+	ans, err := svc.Update(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
+	state.AuthenticationEnforcement = types.StringValue(ans.AuthenticationEnforcement)
+	state.Category = EncodeStringSlice(ans.Category)
+	state.Description = types.StringValue(ans.Description)
+	state.Destination = EncodeStringSlice(ans.Destination)
+	state.DestinationHip = EncodeStringSlice(ans.DestinationHip)
+	state.Disabled = types.BoolValue(ans.Disabled)
+	state.From = EncodeStringSlice(ans.From)
+	state.GroupTag = types.StringValue(ans.GroupTag)
+	state.HipProfiles = EncodeStringSlice(ans.HipProfiles)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.LogAuthenticationTimeout = types.BoolValue(ans.LogAuthenticationTimeout)
+	state.LogSetting = types.StringValue(ans.LogSetting)
+	state.Name = types.StringValue(ans.Name)
+	state.NegateDestination = types.BoolValue(ans.NegateDestination)
+	state.NegateSource = types.BoolValue(ans.NegateSource)
+	state.Service = EncodeStringSlice(ans.Service)
+	state.Source = EncodeStringSlice(ans.Source)
+	state.SourceHip = EncodeStringSlice(ans.SourceHip)
+	state.SourceUser = EncodeStringSlice(ans.SourceUser)
+	state.Tag = EncodeStringSlice(ans.Tag)
+	state.Timeout = types.Int64Value(ans.Timeout)
+	state.To = EncodeStringSlice(ans.To)
+
+	// Move the rule.  The update was applied, so it is saved to state even if
+	// the move fails.  The prior placement is kept in that case, or cleared if
+	// it was the same, so that the next apply retries the move.
+	if !plan.RelativePosition.IsNull() {
+		moveInput := zDUyfEt.MoveInput{
+			ObjectId:        state.ObjectId.ValueString(),
+			Rulebase:        plan.Position.ValueString(),
+			Destination:     plan.RelativePosition.ValueString(),
+			DestinationRule: plan.TargetRule.ValueString(),
+		}
+		if err = svc.Move(ctx, moveInput); err != nil {
+			resp.Diagnostics.AddError("Error moving rule", OperationError(ctx, err))
+			if state.RelativePosition.Equal(plan.RelativePosition) && state.TargetRule.Equal(plan.TargetRule) {
+				state.RelativePosition = types.StringNull()
+				state.TargetRule = types.StringNull()
+			}
+		}
+	}
+	if !resp.Diagnostics.HasError() {
+		state.RelativePosition = plan.RelativePosition
+		state.TargetRule = plan.TargetRule
+	}
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete resource.
+func (r *authenticationRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var idType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &idType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_authentication_rules", "delete", timeouts)
+	defer cancel()
+
+	id := idType.ValueString()
+	tokens := strings.Split(id, IdSeparator)
+	if len(tokens) != 3 {
+		resp.Diagnostics.AddError("Error in resource ID format", "Expected 3 tokens")
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource delete", map[string]any{
+		"terraform_provider_function": "Delete",
+		"resource_name":               "sase_authentication_rules",
+		"locMap":                      map[string]int{"Folder": 1, "ObjectId": 2, "Position": 0},
+		"tokens":                      tokens,
+	})
+
+	svc := zDUyfEt.NewClient(r.client)
+	input := zDUyfEt.DeleteInput{
+		ObjectId: tokens[2],
+	}
+
+	// Perform the operation.
+	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
+	}
+}
+
+// ImportState imports by either the resource ID or by location params and name,
+// such as `position=...,folder=...,name=...`.
+func (r *authenticationRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	params, ok, err := ParseImportId(req.ID, "position", "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_authentication_rules",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := zDUyfEt.NewClient(r.client)
+	input := zDUyfEt.ListInput{
+		Position: params["position"],
+		Folder:   params["folder"],
+		Name:     api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["position"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
+}
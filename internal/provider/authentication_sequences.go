@@ -10,6 +10,7 @@ import (
 	xNwmFxK "github.com/paloaltonetworks/sase-go/netsec/schema/authentication/sequences"
 	dPHRIQI "github.com/paloaltonetworks/sase-go/netsec/service/v1/authenticationsequences"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -41,10 +42,13 @@ type authenticationSequencesListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Folder types.String `tfsdk:"folder"`
-	Name   types.String `tfsdk:"name"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Folder   types.String `tfsdk:"folder"`
+	Name     types.String `tfsdk:"name"`
 
 	// Output.
 	Data []authenticationSequencesListDsModelConfig `tfsdk:"data"`
@@ -90,6 +94,27 @@ func (d *authenticationSequencesListDataSource) Schema(_ context.Context, _ data
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"folder": dsschema.StringAttribute{
 				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
 				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
@@ -188,12 +213,32 @@ func (d *authenticationSequencesListDataSource) Read(ctx context.Context, req da
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -379,7 +424,8 @@ type authenticationSequencesResource struct {
 }
 
 type authenticationSequencesRsModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
 
 	// Input.
 	Folder types.String `tfsdk:"folder"`
@@ -420,6 +466,9 @@ func (r *authenticationSequencesResource) Schema(_ context.Context, _ resource.S
 				Validators: []validator.String{
 					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 
 			"authentication_profiles": rsschema.ListAttribute{
@@ -451,6 +500,9 @@ func (r *authenticationSequencesResource) Schema(_ context.Context, _ resource.S
 				},
 			},
 		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_authentication_sequences"),
+		},
 	}
 }
 
@@ -471,6 +523,9 @@ func (r *authenticationSequencesResource) Create(ctx context.Context, req resour
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_authentication_sequences", "create", state.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource create", map[string]any{
 		"terraform_provider_function": "Create",
@@ -492,7 +547,7 @@ func (r *authenticationSequencesResource) Create(ctx context.Context, req resour
 	// Perform the operation.
 	ans, err := svc.Create(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in create", err.Error())
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
 		return
 	}
 
@@ -526,6 +581,13 @@ func (r *authenticationSequencesResource) Read(ctx context.Context, req resource
 	}
 
 	var state authenticationSequencesRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_authentication_sequences", "read", state.Timeouts)
+	defer cancel()
 
 	// Basic logging.
 	tflog.Info(ctx, "performing resource read", map[string]any{
@@ -547,7 +609,7 @@ func (r *authenticationSequencesResource) Read(ctx context.Context, req resource
 		if IsObjectNotFound(err) {
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error reading config", err.Error())
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
 		}
 		return
 	}
@@ -577,6 +639,9 @@ func (r *authenticationSequencesResource) Update(ctx context.Context, req resour
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_authentication_sequences", "update", plan.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource update", map[string]any{
 		"terraform_provider_function": "Update",
@@ -598,11 +663,12 @@ func (r *authenticationSequencesResource) Update(ctx context.Context, req resour
 	// Perform the operation.
 	ans, err := svc.Update(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in update", err.Error())
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
 		return
 	}
 
 	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
 	state.AuthenticationProfiles = EncodeStringSlice(ans.AuthenticationProfiles)
 	state.ObjectId = types.StringValue(ans.ObjectId)
 	state.Name = types.StringValue(ans.Name)
@@ -619,6 +685,16 @@ func (r *authenticationSequencesResource) Delete(ctx context.Context, req resour
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_authentication_sequences", "delete", timeouts)
+	defer cancel()
+
 	id := idType.ValueString()
 	tokens := strings.Split(id, IdSeparator)
 	if len(tokens) != 2 {
@@ -641,10 +717,59 @@ func (r *authenticationSequencesResource) Delete(ctx context.Context, req resour
 
 	// Perform the operation.
 	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
-		resp.Diagnostics.AddError("Error in delete", err.Error())
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
 	}
 }
 
+// ImportState imports by either the resource ID or by location params and name,
+// such as `folder=...,name=...`.
 func (r *authenticationSequencesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	params, ok, err := ParseImportId(req.ID, "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_authentication_sequences",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := dPHRIQI.NewClient(r.client)
+	input := dPHRIQI.ListInput{
+		Folder: params["folder"],
+		Name:   api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
 }
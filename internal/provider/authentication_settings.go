@@ -7,11 +7,18 @@ import (
 
 	"github.com/paloaltonetworks/sase-go"
 	"github.com/paloaltonetworks/sase-go/api"
+	vZpLyRk "github.com/paloaltonetworks/sase-go/netsec/schema/authentication/settings"
 	uQwObPt "github.com/paloaltonetworks/sase-go/netsec/service/v1/mobileagent/authenticationsettings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rsschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -35,9 +42,12 @@ type authenticationSettingsListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Folder types.String `tfsdk:"folder"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Folder   types.String `tfsdk:"folder"`
 
 	// Output.
 	Data []authenticationSettingsListDsModelConfig `tfsdk:"data"`
@@ -82,6 +92,27 @@ func (d *authenticationSettingsListDataSource) Schema(_ context.Context, _ datas
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"folder": dsschema.StringAttribute{
 				Description:         "The `folder` parameter. Value must be one of: `\"Mobile Users\"`.",
 				MarkdownDescription: "The `folder` parameter. Value must be one of: `\"Mobile Users\"`.",
@@ -164,12 +195,32 @@ func (d *authenticationSettingsListDataSource) Read(ctx context.Context, req dat
 		input.Offset = api.Int(state.Offset.ValueInt64())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -211,3 +262,331 @@ func (d *authenticationSettingsListDataSource) Read(ctx context.Context, req dat
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// Resource.
+var (
+	_ resource.Resource                = &authenticationSettingsResource{}
+	_ resource.ResourceWithConfigure   = &authenticationSettingsResource{}
+	_ resource.ResourceWithImportState = &authenticationSettingsResource{}
+	_ resource.ResourceWithModifyPlan  = &authenticationSettingsResource{}
+)
+
+func NewAuthenticationSettingsResource() resource.Resource {
+	return &authenticationSettingsResource{}
+}
+
+type authenticationSettingsResource struct {
+	client *sase.Client
+}
+
+type authenticationSettingsRsModel struct {
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
+
+	// Input.
+	Folder types.String `tfsdk:"folder"`
+
+	// Request body input.
+	// Ref: #/components/schemas/authentication-settings
+	AuthenticationProfile              types.String `tfsdk:"authentication_profile"`
+	Os                                 types.String `tfsdk:"os"`
+	UserCredentialOrClientCertRequired types.Bool   `tfsdk:"user_credential_or_client_cert_required"`
+}
+
+// Metadata returns the data source type name.
+func (r *authenticationSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authentication_settings"
+}
+
+// Schema defines the schema for this listing data source.
+func (r *authenticationSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rsschema.Schema{
+		Description: "Manages the GlobalProtect mobile agent authentication settings of one operating system. The settings can't be deleted, so deleting this resource only removes it from the state.",
+
+		Attributes: map[string]rsschema.Attribute{
+			"id": rsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Input.
+			"folder": rsschema.StringAttribute{
+				Description:         "The folder of the entry. Value must be one of: `\"Mobile Users\"`.",
+				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Mobile Users\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Mobile Users"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"authentication_profile": rsschema.StringAttribute{
+				Description:         "The `authentication_profile` parameter. The authentication profile must exist in the folder or in a folder that it inherits from, such as `\"Shared\"`, and this is checked at plan time.",
+				MarkdownDescription: "The `authentication_profile` parameter. The authentication profile must exist in the folder or in a folder that it inherits from, such as `\"Shared\"`, and this is checked at plan time.",
+				Required:            true,
+			},
+			"os": rsschema.StringAttribute{
+				Description:         "The `os` parameter. Value must be one of: `\"Any\"`, `\"Android\"`, `\"Browser\"`, `\"Chrome\"`, `\"IoT\"`, `\"Linux\"`, `\"Mac\"`, `\"Satellite\"`, `\"Windows\"`, `\"WindowsUWP\"`, `\"iOS\"`.",
+				MarkdownDescription: "The `os` parameter. Value must be one of: `\"Any\"`, `\"Android\"`, `\"Browser\"`, `\"Chrome\"`, `\"IoT\"`, `\"Linux\"`, `\"Mac\"`, `\"Satellite\"`, `\"Windows\"`, `\"WindowsUWP\"`, `\"iOS\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Any", "Android", "Browser", "Chrome", "IoT", "Linux", "Mac", "Satellite", "Windows", "WindowsUWP", "iOS"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_credential_or_client_cert_required": rsschema.BoolAttribute{
+				Description:         "The `user_credential_or_client_cert_required` parameter.",
+				MarkdownDescription: "The `user_credential_or_client_cert_required` parameter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					DefaultBool(false),
+				},
+			},
+		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_authentication_settings"),
+		},
+	}
+}
+
+// Configure prepares the struct.
+func (r *authenticationSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*sase.Client)
+}
+
+// ModifyPlan checks that the authentication profile exists, so that a missing
+// profile is reported before anything is applied.
+func (r *authenticationSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	CheckPlanReference(ctx, req, resp, r.client, path.Root("authentication_profile"), "authentication profile", path.Root("folder"), AuthenticationProfileExists)
+}
+
+// Create resource
+func (r *authenticationSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state authenticationSettingsRsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_authentication_settings", "create", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource create", map[string]any{
+		"terraform_provider_function": "Create",
+		"resource_name":               "sase_authentication_settings",
+		"folder":                      state.Folder.ValueString(),
+		"os":                          state.Os.ValueString(),
+	})
+
+	// The settings can't be deleted, so settings for this OS may already
+	// exist from an earlier rollout.  If so, take them over with an update.
+	svc := uQwObPt.NewClient(r.client)
+	list, err := svc.List(ctx, uQwObPt.ListInput{
+		Folder: state.Folder.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", OperationError(ctx, err))
+		return
+	}
+	exists := false
+	for _, x := range list.Data {
+		if x.Os == state.Os.ValueString() {
+			exists = true
+			break
+		}
+	}
+
+	// Prepare to create the config.
+	var var0 vZpLyRk.Config
+	var0.AuthenticationProfile = state.AuthenticationProfile.ValueString()
+	var0.Os = state.Os.ValueString()
+	var0.UserCredentialOrClientCertRequired = state.UserCredentialOrClientCertRequired.ValueBool()
+
+	// Perform the operation.
+	var ans vZpLyRk.Config
+	if exists {
+		ans, err = svc.Update(ctx, uQwObPt.UpdateInput{
+			Folder: state.Folder.ValueString(),
+			Config: var0,
+		})
+	} else {
+		ans, err = svc.Create(ctx, uQwObPt.CreateInput{
+			Folder: state.Folder.ValueString(),
+			Config: var0,
+		})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(state.Folder.ValueString())
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(ans.Os)
+	state.Id = types.StringValue(idBuilder.String())
+	state.AuthenticationProfile = types.StringValue(ans.AuthenticationProfile)
+	state.Os = types.StringValue(ans.Os)
+	state.UserCredentialOrClientCertRequired = types.BoolValue(ans.UserCredentialOrClientCertRequired)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read resource.
+func (r *authenticationSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var idType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &idType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := idType.ValueString()
+	tokens := strings.Split(id, IdSeparator)
+	if len(tokens) != 2 {
+		resp.Diagnostics.AddError("Error in resource ID format", "Expected 2 tokens")
+		return
+	}
+
+	var state authenticationSettingsRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_authentication_settings", "read", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource read", map[string]any{
+		"terraform_provider_function": "Read",
+		"resource_name":               "sase_authentication_settings",
+		"locMap":                      map[string]int{"Folder": 0, "Os": 1},
+		"tokens":                      tokens,
+	})
+
+	// Prepare to read the config.
+	svc := uQwObPt.NewClient(r.client)
+	input := uQwObPt.ListInput{
+		Folder: tokens[0],
+	}
+
+	// Perform the operation.
+	list, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
+		return
+	}
+	var ans *vZpLyRk.Config
+	for i := range list.Data {
+		if list.Data[i].Os == tokens[1] {
+			ans = &list.Data[i]
+			break
+		}
+	}
+	if ans == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Store the answer to state.
+	state.Id = idType
+	state.Folder = types.StringValue(tokens[0])
+	state.AuthenticationProfile = types.StringValue(ans.AuthenticationProfile)
+	state.Os = types.StringValue(ans.Os)
+	state.UserCredentialOrClientCertRequired = types.BoolValue(ans.UserCredentialOrClientCertRequired)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update resource.
+func (r *authenticationSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state authenticationSettingsRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_authentication_settings", "update", plan.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource update", map[string]any{
+		"terraform_provider_function": "Update",
+		"resource_name":               "sase_authentication_settings",
+		"folder":                      state.Folder.ValueString(),
+		"os":                          state.Os.ValueString(),
+	})
+
+	// Prepare to update the config.
+	svc := uQwObPt.NewClient(r.client)
+	input := uQwObPt.UpdateInput{
+		Folder: state.Folder.ValueString(),
+	}
+	var var0 vZpLyRk.Config
+	var0.AuthenticationProfile = plan.AuthenticationProfile.ValueString()
+	var0.Os = plan.Os.ValueString()
+	var0.UserCredentialOrClientCertRequired = plan.UserCredentialOrClientCertRequired.ValueBool()
+	input.Config = var0
+
+	// Perform the operation.
+	ans, err := svc.Update(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
+	state.AuthenticationProfile = types.StringValue(ans.AuthenticationProfile)
+	state.Os = types.StringValue(ans.Os)
+	state.UserCredentialOrClientCertRequired = types.BoolValue(ans.UserCredentialOrClientCertRequired)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete resource.
+func (r *authenticationSettingsResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Basic logging.
+	tflog.Info(ctx, "performing resource delete", map[string]any{
+		"terraform_provider_function": "Delete",
+		"resource_name":               "sase_authentication_settings",
+	})
+
+	// The settings can't be deleted, so leave them as they are and only
+	// remove them from the state.
+	resp.Diagnostics.AddWarning(
+		"Settings Left In Place",
+		"The mobile agent authentication settings can't be removed, so they were only removed from the Terraform state.",
+	)
+}
+
+// ImportState imports the settings.  The import ID is "folder:os".
+func (r *authenticationSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -7,11 +7,19 @@ import (
 
 	"github.com/paloaltonetworks/sase-go"
 	"github.com/paloaltonetworks/sase-go/api"
+	wPdKeLq "github.com/paloaltonetworks/sase-go/netsec/schema/auto/tag/actions"
 	iYmUVvF "github.com/paloaltonetworks/sase-go/netsec/service/v1/autotagactions"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rsschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -35,10 +43,13 @@ type autoTagActionsListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Name   types.String `tfsdk:"name"`
-	Folder types.String `tfsdk:"folder"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Name     types.String `tfsdk:"name"`
+	Folder   types.String `tfsdk:"folder"`
 
 	// Output.
 	Data []autoTagActionsListDsModelConfig `tfsdk:"data"`
@@ -104,6 +115,27 @@ func (d *autoTagActionsListDataSource) Schema(_ context.Context, _ datasource.Sc
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"name": dsschema.StringAttribute{
 				Description:         "The name of the entry.",
 				MarkdownDescription: "The name of the entry.",
@@ -265,12 +297,32 @@ func (d *autoTagActionsListDataSource) Read(ctx context.Context, req datasource.
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -339,3 +391,885 @@ func (d *autoTagActionsListDataSource) Read(ctx context.Context, req datasource.
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// Data source.
+var (
+	_ datasource.DataSource              = &autoTagActionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &autoTagActionsDataSource{}
+)
+
+func NewAutoTagActionsDataSource() datasource.DataSource {
+	return &autoTagActionsDataSource{}
+}
+
+type autoTagActionsDataSource struct {
+	client *sase.Client
+}
+
+type autoTagActionsDsModel struct {
+	Id types.String `tfsdk:"id"`
+
+	// Input.
+	ObjectId types.String `tfsdk:"object_id"`
+	Folder   types.String `tfsdk:"folder"`
+
+	// Output.
+	// Ref: #/components/schemas/auto-tag-actions
+	Actions     []autoTagActionsDsModelActionsObject `tfsdk:"actions"`
+	Description types.String                         `tfsdk:"description"`
+	Filter      types.String                         `tfsdk:"filter"`
+	// input omit: ObjectId
+	LogType        types.String `tfsdk:"log_type"`
+	Name           types.String `tfsdk:"name"`
+	Quarantine     types.Bool   `tfsdk:"quarantine"`
+	SendToPanorama types.Bool   `tfsdk:"send_to_panorama"`
+}
+
+type autoTagActionsDsModelActionsObject struct {
+	Name types.String                    `tfsdk:"name"`
+	Type autoTagActionsDsModelTypeObject `tfsdk:"type"`
+}
+
+type autoTagActionsDsModelTypeObject struct {
+	Tagging autoTagActionsDsModelTaggingObject `tfsdk:"tagging"`
+}
+
+type autoTagActionsDsModelTaggingObject struct {
+	Action  types.String   `tfsdk:"action"`
+	Tags    []types.String `tfsdk:"tags"`
+	Target  types.String   `tfsdk:"target"`
+	Timeout types.Int64    `tfsdk:"timeout"`
+}
+
+// Metadata returns the data source type name.
+func (d *autoTagActionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auto_tag_actions"
+}
+
+// Schema defines the schema for this listing data source.
+func (d *autoTagActionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Retrieves config for a specific item.",
+
+		Attributes: map[string]dsschema.Attribute{
+			"id": dsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+			},
+
+			// Input.
+			"object_id": dsschema.StringAttribute{
+				Description:         "The uuid of the resource.",
+				MarkdownDescription: "The uuid of the resource.",
+				Required:            true,
+			},
+			"folder": dsschema.StringAttribute{
+				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`.",
+				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Shared"),
+				},
+			},
+
+			// Output.
+			"actions": dsschema.ListNestedAttribute{
+				Description:         "The `actions` parameter.",
+				MarkdownDescription: "The `actions` parameter.",
+				Computed:            true,
+				NestedObject: dsschema.NestedAttributeObject{
+					Attributes: map[string]dsschema.Attribute{
+						"name": dsschema.StringAttribute{
+							Description:         "The `name` parameter.",
+							MarkdownDescription: "The `name` parameter.",
+							Computed:            true,
+						},
+						"type": dsschema.SingleNestedAttribute{
+							Description:         "The `type` parameter.",
+							MarkdownDescription: "The `type` parameter.",
+							Computed:            true,
+							Attributes: map[string]dsschema.Attribute{
+								"tagging": dsschema.SingleNestedAttribute{
+									Description:         "The `tagging` parameter.",
+									MarkdownDescription: "The `tagging` parameter.",
+									Computed:            true,
+									Attributes: map[string]dsschema.Attribute{
+										"action": dsschema.StringAttribute{
+											Description:         "The `action` parameter.",
+											MarkdownDescription: "The `action` parameter.",
+											Computed:            true,
+										},
+										"tags": dsschema.ListAttribute{
+											Description:         "The `tags` parameter.",
+											MarkdownDescription: "The `tags` parameter.",
+											Computed:            true,
+											ElementType:         types.StringType,
+										},
+										"target": dsschema.StringAttribute{
+											Description:         "The `target` parameter.",
+											MarkdownDescription: "The `target` parameter.",
+											Computed:            true,
+										},
+										"timeout": dsschema.Int64Attribute{
+											Description:         "The `timeout` parameter.",
+											MarkdownDescription: "The `timeout` parameter.",
+											Computed:            true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"description": dsschema.StringAttribute{
+				Description:         "The `description` parameter.",
+				MarkdownDescription: "The `description` parameter.",
+				Computed:            true,
+			},
+			"filter": dsschema.StringAttribute{
+				Description:         "The `filter` parameter.",
+				MarkdownDescription: "The `filter` parameter.",
+				Computed:            true,
+			},
+			"log_type": dsschema.StringAttribute{
+				Description:         "The `log_type` parameter.",
+				MarkdownDescription: "The `log_type` parameter.",
+				Computed:            true,
+			},
+			"name": dsschema.StringAttribute{
+				Description:         "The `name` parameter.",
+				MarkdownDescription: "The `name` parameter.",
+				Computed:            true,
+			},
+			"quarantine": dsschema.BoolAttribute{
+				Description:         "The `quarantine` parameter.",
+				MarkdownDescription: "The `quarantine` parameter.",
+				Computed:            true,
+			},
+			"send_to_panorama": dsschema.BoolAttribute{
+				Description:         "The `send_to_panorama` parameter.",
+				MarkdownDescription: "The `send_to_panorama` parameter.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure prepares the struct.
+func (d *autoTagActionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*sase.Client)
+}
+
+func (d *autoTagActionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state autoTagActionsDsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing data source singleton retrieval", map[string]any{
+		"terraform_provider_function": "Read",
+		"data_source_name":            "sase_auto_tag_actions",
+		"object_id":                   state.ObjectId.ValueString(),
+		"folder":                      state.Folder.ValueString(),
+	})
+
+	// Prepare to run the command.
+	svc := iYmUVvF.NewClient(d.client)
+	input := iYmUVvF.ReadInput{
+		ObjectId: state.ObjectId.ValueString(),
+		Folder:   state.Folder.ValueString(),
+	}
+
+	// Perform the operation.
+	ans, err := svc.Read(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting singleton", err.Error())
+		return
+	}
+
+	// Store the answer to state.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(input.ObjectId)
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(input.Folder)
+	state.Id = types.StringValue(idBuilder.String())
+	var var0 []autoTagActionsDsModelActionsObject
+	if len(ans.Actions) != 0 {
+		var0 = make([]autoTagActionsDsModelActionsObject, 0, len(ans.Actions))
+		for var1Index := range ans.Actions {
+			var1 := ans.Actions[var1Index]
+			var var2 autoTagActionsDsModelActionsObject
+			var var3 autoTagActionsDsModelTypeObject
+			var var4 autoTagActionsDsModelTaggingObject
+			var4.Action = types.StringValue(var1.Type.Tagging.Action)
+			var4.Tags = EncodeStringSlice(var1.Type.Tagging.Tags)
+			var4.Target = types.StringValue(var1.Type.Tagging.Target)
+			var4.Timeout = types.Int64Value(var1.Type.Tagging.Timeout)
+			var3.Tagging = var4
+			var2.Name = types.StringValue(var1.Name)
+			var2.Type = var3
+			var0 = append(var0, var2)
+		}
+	}
+	state.Actions = var0
+	state.Description = types.StringValue(ans.Description)
+	state.Filter = types.StringValue(ans.Filter)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.LogType = types.StringValue(ans.LogType)
+	state.Name = types.StringValue(ans.Name)
+	state.Quarantine = types.BoolValue(ans.Quarantine)
+	state.SendToPanorama = types.BoolValue(ans.SendToPanorama)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Resource.
+var (
+	_ resource.Resource                = &autoTagActionsResource{}
+	_ resource.ResourceWithConfigure   = &autoTagActionsResource{}
+	_ resource.ResourceWithImportState = &autoTagActionsResource{}
+	_ resource.ResourceWithModifyPlan  = &autoTagActionsResource{}
+)
+
+func NewAutoTagActionsResource() resource.Resource {
+	return &autoTagActionsResource{}
+}
+
+type autoTagActionsResource struct {
+	client *sase.Client
+}
+
+type autoTagActionsRsModel struct {
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
+
+	// Input.
+	Folder types.String `tfsdk:"folder"`
+
+	// Request body input.
+	// Ref: #/components/schemas/auto-tag-actions
+	Actions        []autoTagActionsRsModelActionsObject `tfsdk:"actions"`
+	Description    types.String                         `tfsdk:"description"`
+	Filter         types.String                         `tfsdk:"filter"`
+	ObjectId       types.String                         `tfsdk:"object_id"`
+	LogType        types.String                         `tfsdk:"log_type"`
+	Name           types.String                         `tfsdk:"name"`
+	Quarantine     types.Bool                           `tfsdk:"quarantine"`
+	SendToPanorama types.Bool                           `tfsdk:"send_to_panorama"`
+}
+
+type autoTagActionsRsModelActionsObject struct {
+	Name types.String                    `tfsdk:"name"`
+	Type autoTagActionsRsModelTypeObject `tfsdk:"type"`
+}
+
+type autoTagActionsRsModelTypeObject struct {
+	Tagging autoTagActionsRsModelTaggingObject `tfsdk:"tagging"`
+}
+
+type autoTagActionsRsModelTaggingObject struct {
+	Action  types.String   `tfsdk:"action"`
+	Tags    []types.String `tfsdk:"tags"`
+	Target  types.String   `tfsdk:"target"`
+	Timeout types.Int64    `tfsdk:"timeout"`
+}
+
+// Metadata returns the data source type name.
+func (r *autoTagActionsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auto_tag_actions"
+}
+
+// Schema defines the schema for this listing data source.
+func (r *autoTagActionsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rsschema.Schema{
+		Description: "Retrieves config for a specific item.",
+
+		Attributes: map[string]rsschema.Attribute{
+			"id": rsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Input.
+			"folder": rsschema.StringAttribute{
+				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`.",
+				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Shared"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"actions": rsschema.ListNestedAttribute{
+				Description:         "The `actions` parameter.",
+				MarkdownDescription: "The `actions` parameter.",
+				Required:            true,
+				NestedObject: rsschema.NestedAttributeObject{
+					Attributes: map[string]rsschema.Attribute{
+						"name": rsschema.StringAttribute{
+							Description:         "The `name` parameter. String length must be at most 63.",
+							MarkdownDescription: "The `name` parameter. String length must be at most 63.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(63),
+							},
+						},
+						"type": rsschema.SingleNestedAttribute{
+							Description:         "The `type` parameter.",
+							MarkdownDescription: "The `type` parameter.",
+							Required:            true,
+							Attributes: map[string]rsschema.Attribute{
+								"tagging": rsschema.SingleNestedAttribute{
+									Description:         "The `tagging` parameter.",
+									MarkdownDescription: "The `tagging` parameter.",
+									Required:            true,
+									Attributes: map[string]rsschema.Attribute{
+										"action": rsschema.StringAttribute{
+											Description:         "The `action` parameter. Value must be one of: `\"add-tag\"`, `\"remove-tag\"`.",
+											MarkdownDescription: "The `action` parameter. Value must be one of: `\"add-tag\"`, `\"remove-tag\"`.",
+											Required:            true,
+											Validators: []validator.String{
+												stringvalidator.OneOf("add-tag", "remove-tag"),
+											},
+										},
+										"tags": rsschema.ListAttribute{
+											Description:         "The `tags` parameter. Each tag must exist in the folder, and this is checked at plan time. List must have at least 1 element.",
+											MarkdownDescription: "The `tags` parameter. Each tag must exist in the folder, and this is checked at plan time. List must have at least 1 element.",
+											Optional:            true,
+											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtLeast(1),
+											},
+										},
+										"target": rsschema.StringAttribute{
+											Description:         "The `target` parameter.",
+											MarkdownDescription: "The `target` parameter.",
+											Required:            true,
+										},
+										"timeout": rsschema.Int64Attribute{
+											Description:         "The `timeout` parameter. Value must be between 0 and 43200.",
+											MarkdownDescription: "The `timeout` parameter. Value must be between 0 and 43200.",
+											Optional:            true,
+											Computed:            true,
+											PlanModifiers: []planmodifier.Int64{
+												DefaultInt64(0),
+											},
+											Validators: []validator.Int64{
+												int64validator.Between(0, 43200),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"description": rsschema.StringAttribute{
+				Description:         "The `description` parameter. String length must be at most 1024.",
+				MarkdownDescription: "The `description` parameter. String length must be at most 1024.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					DefaultString(""),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			"filter": rsschema.StringAttribute{
+				Description:         "The `filter` parameter. String length must be at most 2047.",
+				MarkdownDescription: "The `filter` parameter. String length must be at most 2047.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2047),
+				},
+			},
+			"object_id": rsschema.StringAttribute{
+				Description:         "The `object_id` parameter.",
+				MarkdownDescription: "The `object_id` parameter.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"log_type": rsschema.StringAttribute{
+				Description:         "The `log_type` parameter.",
+				MarkdownDescription: "The `log_type` parameter.",
+				Required:            true,
+			},
+			"name": rsschema.StringAttribute{
+				Description:         "The `name` parameter. String length must be at most 63.",
+				MarkdownDescription: "The `name` parameter. String length must be at most 63.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(63),
+				},
+			},
+			"quarantine": rsschema.BoolAttribute{
+				Description:         "The `quarantine` parameter.",
+				MarkdownDescription: "The `quarantine` parameter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					DefaultBool(false),
+				},
+			},
+			"send_to_panorama": rsschema.BoolAttribute{
+				Description:         "The `send_to_panorama` parameter.",
+				MarkdownDescription: "The `send_to_panorama` parameter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					DefaultBool(false),
+				},
+			},
+		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_auto_tag_actions"),
+		},
+	}
+}
+
+// Configure prepares the struct.
+func (r *autoTagActionsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*sase.Client)
+}
+
+// ModifyPlan checks that the tags applied by the actions exist, so that a
+// missing tag is reported before anything is applied.
+func (r *autoTagActionsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var folder types.String
+	var actions types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("folder"), &folder)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("actions"), &actions)...)
+	if resp.Diagnostics.HasError() || folder.IsUnknown() || actions.IsUnknown() {
+		return
+	}
+
+	// Only look the tags up again if they changed.
+	if !req.State.Raw.IsNull() {
+		var priorFolder types.String
+		var priorActions types.List
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("folder"), &priorFolder)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("actions"), &priorActions)...)
+		if resp.Diagnostics.HasError() || (priorFolder.Equal(folder) && priorActions.Equal(actions)) {
+			return
+		}
+	}
+
+	for i := range actions.Elements() {
+		p := path.Root("actions").AtListIndex(i).AtName("type").AtName("tagging").AtName("tags")
+		var tags types.List
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, p, &tags)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if tags.IsNull() || tags.IsUnknown() {
+			continue
+		}
+
+		var values []types.String
+		resp.Diagnostics.Append(tags.ElementsAs(ctx, &values, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for j, tag := range values {
+			CheckReference(ctx, &resp.Diagnostics, r.client, p.AtListIndex(j), "tag", folder.ValueString(), tag, TagExists)
+		}
+	}
+}
+
+// Create resource
+func (r *autoTagActionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state autoTagActionsRsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_auto_tag_actions", "create", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource create", map[string]any{
+		"terraform_provider_function": "Create",
+		"resource_name":               "sase_auto_tag_actions",
+		"folder":                      state.Folder.ValueString(),
+	})
+
+	// Prepare to create the config.
+	svc := iYmUVvF.NewClient(r.client)
+	input := iYmUVvF.CreateInput{
+		Folder: state.Folder.ValueString(),
+	}
+	var var0 wPdKeLq.Config
+	var var1 []wPdKeLq.ActionsObject
+	if len(state.Actions) != 0 {
+		var1 = make([]wPdKeLq.ActionsObject, 0, len(state.Actions))
+		for var2Index := range state.Actions {
+			var2 := state.Actions[var2Index]
+			var var3 wPdKeLq.ActionsObject
+			var3.Name = var2.Name.ValueString()
+			var var4 wPdKeLq.TypeObject
+			var var5 wPdKeLq.TaggingObject
+			var5.Action = var2.Type.Tagging.Action.ValueString()
+			var5.Tags = DecodeStringSlice(var2.Type.Tagging.Tags)
+			var5.Target = var2.Type.Tagging.Target.ValueString()
+			var5.Timeout = var2.Type.Tagging.Timeout.ValueInt64()
+			var4.Tagging = var5
+			var3.Type = var4
+			var1 = append(var1, var3)
+		}
+	}
+	var0.Actions = var1
+	var0.Description = state.Description.ValueString()
+	var0.Filter = state.Filter.ValueString()
+	var0.LogType = state.LogType.ValueString()
+	var0.Name = state.Name.ValueString()
+	var0.Quarantine = state.Quarantine.ValueBool()
+	var0.SendToPanorama = state.SendToPanorama.ValueBool()
+	input.Config = var0
+
+	// Perform the operation.
+	ans, err := svc.Create(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(input.Folder)
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(ans.ObjectId)
+	state.Id = types.StringValue(idBuilder.String())
+	var var6 []autoTagActionsRsModelActionsObject
+	if len(ans.Actions) != 0 {
+		var6 = make([]autoTagActionsRsModelActionsObject, 0, len(ans.Actions))
+		for var7Index := range ans.Actions {
+			var7 := ans.Actions[var7Index]
+			var var8 autoTagActionsRsModelActionsObject
+			var var9 autoTagActionsRsModelTypeObject
+			var var10 autoTagActionsRsModelTaggingObject
+			var10.Action = types.StringValue(var7.Type.Tagging.Action)
+			var10.Tags = EncodeStringSlice(var7.Type.Tagging.Tags)
+			var10.Target = types.StringValue(var7.Type.Tagging.Target)
+			var10.Timeout = types.Int64Value(var7.Type.Tagging.Timeout)
+			var9.Tagging = var10
+			var8.Name = types.StringValue(var7.Name)
+			var8.Type = var9
+			var6 = append(var6, var8)
+		}
+	}
+	state.Actions = var6
+	state.Description = types.StringValue(ans.Description)
+	state.Filter = types.StringValue(ans.Filter)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.LogType = types.StringValue(ans.LogType)
+	state.Name = types.StringValue(ans.Name)
+	state.Quarantine = types.BoolValue(ans.Quarantine)
+	state.SendToPanorama = types.BoolValue(ans.SendToPanorama)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read resource.
+func (r *autoTagActionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var idType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &idType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := idType.ValueString()
+	tokens := strings.Split(id, IdSeparator)
+	if len(tokens) != 2 {
+		resp.Diagnostics.AddError("Error in resource ID format", "Expected 2 tokens")
+		return
+	}
+
+	var state autoTagActionsRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_auto_tag_actions", "read", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource read", map[string]any{
+		"terraform_provider_function": "Read",
+		"resource_name":               "sase_auto_tag_actions",
+		"locMap":                      map[string]int{"Folder": 0, "ObjectId": 1},
+		"tokens":                      tokens,
+	})
+
+	// Prepare to read the config.
+	svc := iYmUVvF.NewClient(r.client)
+	input := iYmUVvF.ReadInput{
+		ObjectId: tokens[1],
+		Folder:   tokens[0],
+	}
+
+	// Perform the operation.
+	ans, err := svc.Read(ctx, input)
+	if err != nil {
+		if IsObjectNotFound(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
+		}
+		return
+	}
+
+	// Store the answer to state.
+	state.Folder = types.StringValue(tokens[0])
+	state.Id = idType
+	var var0 []autoTagActionsRsModelActionsObject
+	if len(ans.Actions) != 0 {
+		var0 = make([]autoTagActionsRsModelActionsObject, 0, len(ans.Actions))
+		for var1Index := range ans.Actions {
+			var1 := ans.Actions[var1Index]
+			var var2 autoTagActionsRsModelActionsObject
+			var var3 autoTagActionsRsModelTypeObject
+			var var4 autoTagActionsRsModelTaggingObject
+			var4.Action = types.StringValue(var1.Type.Tagging.Action)
+			var4.Tags = EncodeStringSlice(var1.Type.Tagging.Tags)
+			var4.Target = types.StringValue(var1.Type.Tagging.Target)
+			var4.Timeout = types.Int64Value(var1.Type.Tagging.Timeout)
+			var3.Tagging = var4
+			var2.Name = types.StringValue(var1.Name)
+			var2.Type = var3
+			var0 = append(var0, var2)
+		}
+	}
+	state.Actions = var0
+	state.Description = types.StringValue(ans.Description)
+	state.Filter = types.StringValue(ans.Filter)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.LogType = types.StringValue(ans.LogType)
+	state.Name = types.StringValue(ans.Name)
+	state.Quarantine = types.BoolValue(ans.Quarantine)
+	state.SendToPanorama = types.BoolValue(ans.SendToPanorama)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update resource.
+func (r *autoTagActionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state autoTagActionsRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_auto_tag_actions", "update", plan.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource update", map[string]any{
+		"terraform_provider_function": "Update",
+		"resource_name":               "sase_auto_tag_actions",
+		"object_id":                   state.ObjectId.ValueString(),
+	})
+
+	// Prepare to create the config.
+	svc := iYmUVvF.NewClient(r.client)
+	input := iYmUVvF.UpdateInput{
+		ObjectId: state.ObjectId.ValueString(),
+	}
+	var var0 wPdKeLq.Config
+	var var1 []wPdKeLq.ActionsObject
+	if len(plan.Actions) != 0 {
+		var1 = make([]wPdKeLq.ActionsObject, 0, len(plan.Actions))
+		for var2Index := range plan.Actions {
+			var2 := plan.Actions[var2Index]
+			var var3 wPdKeLq.ActionsObject
+			var3.Name = var2.Name.ValueString()
+			var var4 wPdKeLq.TypeObject
+			var var5 wPdKeLq.TaggingObject
+			var5.Action = var2.Type.Tagging.Action.ValueString()
+			var5.Tags = DecodeStringSlice(var2.Type.Tagging.Tags)
+			var5.Target = var2.Type.Tagging.Target.ValueString()
+			var5.Timeout = var2.Type.Tagging.Timeout.ValueInt64()
+			var4.Tagging = var5
+			var3.Type = var4
+			var1 = append(var1, var3)
+		}
+	}
+	var0.Actions = var1
+	var0.Description = plan.Description.ValueString()
+	var0.Filter = plan.Filter.ValueString()
+	var0.LogType = plan.LogType.ValueString()
+	var0.Name = plan.Name.ValueString()
+	var0.Quarantine = plan.Quarantine.ValueBool()
+	var0.SendToPanorama = plan.SendToPanorama.ValueBool()
+	input.Config = var0
+
+	// Perform the operation.
+	ans, err := svc.Update(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
+	var var6 []autoTagActionsRsModelActionsObject
+	if len(ans.Actions) != 0 {
+		var6 = make([]autoTagActionsRsModelActionsObject, 0, len(ans.Actions))
+		for var7Index := range ans.Actions {
+			var7 := ans.Actions[var7Index]
+			var var8 autoTagActionsRsModelActionsObject
+			var var9 autoTagActionsRsModelTypeObject
+			var var10 autoTagActionsRsModelTaggingObject
+			var10.Action = types.StringValue(var7.Type.Tagging.Action)
+			var10.Tags = EncodeStringSlice(var7.Type.Tagging.Tags)
+			var10.Target = types.StringValue(var7.Type.Tagging.Target)
+			var10.Timeout = types.Int64Value(var7.Type.Tagging.Timeout)
+			var9.Tagging = var10
+			var8.Name = types.StringValue(var7.Name)
+			var8.Type = var9
+			var6 = append(var6, var8)
+		}
+	}
+	state.Actions = var6
+	state.Description = types.StringValue(ans.Description)
+	state.Filter = types.StringValue(ans.Filter)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.LogType = types.StringValue(ans.LogType)
+	state.Name = types.StringValue(ans.Name)
+	state.Quarantine = types.BoolValue(ans.Quarantine)
+	state.SendToPanorama = types.BoolValue(ans.SendToPanorama)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete resource.
+func (r *autoTagActionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var idType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &idType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_auto_tag_actions", "delete", timeouts)
+	defer cancel()
+
+	id := idType.ValueString()
+	tokens := strings.Split(id, IdSeparator)
+	if len(tokens) != 2 {
+		resp.Diagnostics.AddError("Error in resource ID format", "Expected 2 tokens")
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource delete", map[string]any{
+		"terraform_provider_function": "Delete",
+		"resource_name":               "sase_auto_tag_actions",
+		"locMap":                      map[string]int{"Folder": 0, "ObjectId": 1},
+		"tokens":                      tokens,
+	})
+
+	svc := iYmUVvF.NewClient(r.client)
+	input := iYmUVvF.DeleteInput{
+		ObjectId: tokens[1],
+	}
+
+	// Perform the operation.
+	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
+	}
+}
+
+// ImportState imports by either the resource ID or by location params and name,
+// such as `folder=...,name=...`.
+func (r *autoTagActionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	params, ok, err := ParseImportId(req.ID, "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_auto_tag_actions",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := iYmUVvF.NewClient(r.client)
+	input := iYmUVvF.ListInput{
+		Folder: params["folder"],
+		Name:   api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
+}
@@ -7,10 +7,19 @@ import (
 
 	"github.com/paloaltonetworks/sase-go"
 	"github.com/paloaltonetworks/sase-go/api"
+	hVbRqTs "github.com/paloaltonetworks/sase-go/netsec/schema/bandwidth/allocations"
 	snSEbPJ "github.com/paloaltonetworks/sase-go/netsec/service/v1/bandwidthallocations"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rsschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -33,8 +42,11 @@ type bandwidthAllocationsListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64 `tfsdk:"limit"`
-	Offset types.Int64 `tfsdk:"offset"`
+	Limit    types.Int64 `tfsdk:"limit"`
+	Offset   types.Int64 `tfsdk:"offset"`
+	FetchAll types.Bool  `tfsdk:"fetch_all"`
+	PageSize types.Int64 `tfsdk:"page_size"`
+	MaxPages types.Int64 `tfsdk:"max_pages"`
 
 	// Output.
 	Data []bandwidthAllocationsListDsModelConfig `tfsdk:"data"`
@@ -88,6 +100,27 @@ func (d *bandwidthAllocationsListDataSource) Schema(_ context.Context, _ datasou
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 
 			// Output.
 			"data": dsschema.ListNestedAttribute{
@@ -192,12 +225,32 @@ func (d *bandwidthAllocationsListDataSource) Read(ctx context.Context, req datas
 		input.Offset = api.Int(state.Offset.ValueInt64())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -247,3 +300,445 @@ func (d *bandwidthAllocationsListDataSource) Read(ctx context.Context, req datas
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// Resource.
+var (
+	_ resource.Resource                = &bandwidthAllocationsResource{}
+	_ resource.ResourceWithConfigure   = &bandwidthAllocationsResource{}
+	_ resource.ResourceWithImportState = &bandwidthAllocationsResource{}
+	_ resource.ResourceWithModifyPlan  = &bandwidthAllocationsResource{}
+)
+
+func NewBandwidthAllocationsResource() resource.Resource {
+	return &bandwidthAllocationsResource{}
+}
+
+type bandwidthAllocationsResource struct {
+	client *sase.Client
+}
+
+type bandwidthAllocationsRsModel struct {
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
+
+	// Request body input.
+	// Ref: #/components/schemas/bandwidth-allocations
+	AllocatedBandwidth types.Int64                           `tfsdk:"allocated_bandwidth"`
+	ObjectId           types.String                          `tfsdk:"object_id"`
+	Name               types.String                          `tfsdk:"name"`
+	Qos                *bandwidthAllocationsRsModelQosObject `tfsdk:"qos"`
+	SpnNameList        types.List                            `tfsdk:"spn_name_list"`
+}
+
+type bandwidthAllocationsRsModelQosObject struct {
+	Customized      types.Bool   `tfsdk:"customized"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	GuaranteedRatio types.Int64  `tfsdk:"guaranteed_ratio"`
+	Profile         types.String `tfsdk:"profile"`
+}
+
+// Metadata returns the data source type name.
+func (r *bandwidthAllocationsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bandwidth_allocations"
+}
+
+// Schema defines the schema for this listing data source.
+func (r *bandwidthAllocationsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rsschema.Schema{
+		Description: "Manages the bandwidth allocated to remote networks in a region, keyed by region name.",
+
+		Attributes: map[string]rsschema.Attribute{
+			"id": rsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"allocated_bandwidth": rsschema.Int64Attribute{
+				Description:         "The bandwidth allocated to the region, in Mbps. Value must be at least 1.",
+				MarkdownDescription: "The bandwidth allocated to the region, in Mbps. Value must be at least 1.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"object_id": rsschema.StringAttribute{
+				Description:         "The `object_id` parameter.",
+				MarkdownDescription: "The `object_id` parameter.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": rsschema.StringAttribute{
+				Description:         "The name of the region.",
+				MarkdownDescription: "The name of the region.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"qos": rsschema.SingleNestedAttribute{
+				Description:         "The `qos` parameter.",
+				MarkdownDescription: "The `qos` parameter.",
+				Optional:            true,
+				Attributes: map[string]rsschema.Attribute{
+					"customized": rsschema.BoolAttribute{
+						Description:         "The `customized` parameter.",
+						MarkdownDescription: "The `customized` parameter.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							DefaultBool(false),
+						},
+					},
+					"enabled": rsschema.BoolAttribute{
+						Description:         "The `enabled` parameter.",
+						MarkdownDescription: "The `enabled` parameter.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							DefaultBool(false),
+						},
+					},
+					"guaranteed_ratio": rsschema.Int64Attribute{
+						Description:         "The percentage of the allocated bandwidth that is guaranteed. Value must be between 0 and 100.",
+						MarkdownDescription: "The percentage of the allocated bandwidth that is guaranteed. Value must be between 0 and 100.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							DefaultInt64(0),
+						},
+						Validators: []validator.Int64{
+							int64validator.Between(0, 100),
+						},
+					},
+					"profile": rsschema.StringAttribute{
+						Description:         "The QoS profile. The profile must exist in the `\"Remote Networks\"` folder or in `\"Shared\"`, and this is checked at plan time.",
+						MarkdownDescription: "The QoS profile. The profile must exist in the `\"Remote Networks\"` folder or in `\"Shared\"`, and this is checked at plan time.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							DefaultString(""),
+						},
+					},
+				},
+			},
+			"spn_name_list": rsschema.ListAttribute{
+				Description:         "The `spn_name_list` parameter. If unset, the list assigned by the API is kept.",
+				MarkdownDescription: "The `spn_name_list` parameter. If unset, the list assigned by the API is kept.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_bandwidth_allocations"),
+		},
+	}
+}
+
+// Configure prepares the struct.
+func (r *bandwidthAllocationsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*sase.Client)
+}
+
+// ModifyPlan checks that the QoS profile exists.
+func (r *bandwidthAllocationsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var qos types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("qos"), &qos)...)
+	if resp.Diagnostics.HasError() || qos.IsNull() || qos.IsUnknown() {
+		return
+	}
+
+	var profile types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("qos").AtName("profile"), &profile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	CheckReference(ctx, &resp.Diagnostics, r.client, path.Root("qos").AtName("profile"), "QoS profile", "Remote Networks", profile, QosProfileExists)
+}
+
+// Create resource.
+func (r *bandwidthAllocationsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state bandwidthAllocationsRsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_bandwidth_allocations", "create", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource create", map[string]any{
+		"terraform_provider_function": "Create",
+		"resource_name":               "sase_bandwidth_allocations",
+		"name":                        state.Name.ValueString(),
+	})
+
+	// Prepare to create the config.
+	svc := snSEbPJ.NewClient(r.client)
+	input := snSEbPJ.CreateInput{}
+	var var0 hVbRqTs.Config
+	var0.AllocatedBandwidth = state.AllocatedBandwidth.ValueInt64()
+	var0.Name = state.Name.ValueString()
+	var var1 *hVbRqTs.QosObject
+	if state.Qos != nil {
+		var1 = &hVbRqTs.QosObject{}
+		var1.Customized = state.Qos.Customized.ValueBool()
+		var1.Enabled = state.Qos.Enabled.ValueBool()
+		var1.GuaranteedRatio = state.Qos.GuaranteedRatio.ValueInt64()
+		var1.Profile = state.Qos.Profile.ValueString()
+	}
+	var0.Qos = var1
+	var0.SpnNameList = DecodeStringList(state.SpnNameList)
+	input.Config = var0
+
+	// Perform the operation.
+	ans, err := svc.Create(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	state.Id = types.StringValue(ans.Name)
+	var var2 *bandwidthAllocationsRsModelQosObject
+	if ans.Qos != nil {
+		var2 = &bandwidthAllocationsRsModelQosObject{}
+		var2.Customized = types.BoolValue(ans.Qos.Customized)
+		var2.Enabled = types.BoolValue(ans.Qos.Enabled)
+		var2.GuaranteedRatio = types.Int64Value(ans.Qos.GuaranteedRatio)
+		var2.Profile = types.StringValue(ans.Qos.Profile)
+	}
+	state.AllocatedBandwidth = types.Int64Value(ans.AllocatedBandwidth)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.Name = types.StringValue(ans.Name)
+	state.Qos = var2
+	state.SpnNameList = EncodeStringList(ans.SpnNameList)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read resource.
+func (r *bandwidthAllocationsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var idType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &idType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := idType.ValueString()
+
+	var state bandwidthAllocationsRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_bandwidth_allocations", "read", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource read", map[string]any{
+		"terraform_provider_function": "Read",
+		"resource_name":               "sase_bandwidth_allocations",
+		"name":                        id,
+	})
+
+	// Prepare to read the config.
+	svc := snSEbPJ.NewClient(r.client)
+	input := snSEbPJ.ListInput{
+		Limit: api.Int(DefaultPageSize),
+	}
+
+	// Perform the operation.  The listing can't be filtered by region, so
+	// all pages are fetched.
+	list, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
+		return
+	}
+	resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(list.Data)), list.Total, 0, func(offset int64) (int, error) {
+		pageInput := input
+		pageInput.Offset = api.Int(offset)
+		page, err := svc.List(ctx, pageInput)
+		if err != nil {
+			return 0, err
+		}
+		list.Data = append(list.Data, page.Data...)
+		return len(page.Data), nil
+	})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var ans *hVbRqTs.Config
+	for i := range list.Data {
+		if list.Data[i].Name == id {
+			ans = &list.Data[i]
+			break
+		}
+	}
+	if ans == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Store the answer to state.
+	state.Id = idType
+	var var0 *bandwidthAllocationsRsModelQosObject
+	if ans.Qos != nil {
+		var0 = &bandwidthAllocationsRsModelQosObject{}
+		var0.Customized = types.BoolValue(ans.Qos.Customized)
+		var0.Enabled = types.BoolValue(ans.Qos.Enabled)
+		var0.GuaranteedRatio = types.Int64Value(ans.Qos.GuaranteedRatio)
+		var0.Profile = types.StringValue(ans.Qos.Profile)
+	}
+	state.AllocatedBandwidth = types.Int64Value(ans.AllocatedBandwidth)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.Name = types.StringValue(ans.Name)
+	state.Qos = var0
+	state.SpnNameList = EncodeStringList(ans.SpnNameList)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update resource.
+func (r *bandwidthAllocationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state bandwidthAllocationsRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_bandwidth_allocations", "update", plan.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource update", map[string]any{
+		"terraform_provider_function": "Update",
+		"resource_name":               "sase_bandwidth_allocations",
+		"name":                        state.Name.ValueString(),
+	})
+
+	// Prepare to update the config.
+	svc := snSEbPJ.NewClient(r.client)
+	input := snSEbPJ.UpdateInput{}
+	var var0 hVbRqTs.Config
+	var0.AllocatedBandwidth = plan.AllocatedBandwidth.ValueInt64()
+	var0.Name = plan.Name.ValueString()
+	var var1 *hVbRqTs.QosObject
+	if plan.Qos != nil {
+		var1 = &hVbRqTs.QosObject{}
+		var1.Customized = plan.Qos.Customized.ValueBool()
+		var1.Enabled = plan.Qos.Enabled.ValueBool()
+		var1.GuaranteedRatio = plan.Qos.GuaranteedRatio.ValueInt64()
+		var1.Profile = plan.Qos.Profile.ValueString()
+	}
+	var0.Qos = var1
+	var0.SpnNameList = DecodeStringList(plan.SpnNameList)
+	input.Config = var0
+
+	// Perform the operation.
+	ans, err := svc.Update(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
+	var var2 *bandwidthAllocationsRsModelQosObject
+	if ans.Qos != nil {
+		var2 = &bandwidthAllocationsRsModelQosObject{}
+		var2.Customized = types.BoolValue(ans.Qos.Customized)
+		var2.Enabled = types.BoolValue(ans.Qos.Enabled)
+		var2.GuaranteedRatio = types.Int64Value(ans.Qos.GuaranteedRatio)
+		var2.Profile = types.StringValue(ans.Qos.Profile)
+	}
+	state.AllocatedBandwidth = types.Int64Value(ans.AllocatedBandwidth)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.Name = types.StringValue(ans.Name)
+	state.Qos = var2
+	state.SpnNameList = EncodeStringList(ans.SpnNameList)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete resource.
+func (r *bandwidthAllocationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state bandwidthAllocationsRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_bandwidth_allocations", "delete", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource delete", map[string]any{
+		"terraform_provider_function": "Delete",
+		"resource_name":               "sase_bandwidth_allocations",
+		"name":                        state.Name.ValueString(),
+	})
+
+	svc := snSEbPJ.NewClient(r.client)
+	input := snSEbPJ.DeleteInput{
+		Name:        state.Name.ValueString(),
+		SpnNameList: strings.Join(DecodeStringList(state.SpnNameList), ","),
+	}
+
+	// Perform the operation.
+	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
+	}
+}
+
+// ImportState imports by either the resource ID, which is the region name, or
+// by name, such as `name=...`.
+func (r *bandwidthAllocationsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	params, ok, err := ParseImportId(req.ID, "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_bandwidth_allocations",
+		"params":                      params,
+	})
+
+	// Store the canonical ID.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), params["name"])...)
+}
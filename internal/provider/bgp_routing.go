@@ -7,11 +7,19 @@ import (
 
 	"github.com/paloaltonetworks/sase-go"
 	"github.com/paloaltonetworks/sase-go/api"
+	gJsQeVw "github.com/paloaltonetworks/sase-go/netsec/schema/bgp/routing"
 	fhcUKOQ "github.com/paloaltonetworks/sase-go/netsec/service/v1/bgprouting"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rsschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -35,9 +43,12 @@ type bgpRoutingListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Folder types.String `tfsdk:"folder"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Folder   types.String `tfsdk:"folder"`
 
 	// Output.
 	Data []bgpRoutingListDsModelConfig `tfsdk:"data"`
@@ -90,6 +101,27 @@ func (d *bgpRoutingListDataSource) Schema(_ context.Context, _ datasource.Schema
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"folder": dsschema.StringAttribute{
 				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
 				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
@@ -200,12 +232,32 @@ func (d *bgpRoutingListDataSource) Read(ctx context.Context, req datasource.Read
 		input.Offset = api.Int(state.Offset.ValueInt64())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -260,3 +312,452 @@ func (d *bgpRoutingListDataSource) Read(ctx context.Context, req datasource.Read
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// Resource.
+var (
+	_ resource.Resource                     = &bgpRoutingResource{}
+	_ resource.ResourceWithConfigure        = &bgpRoutingResource{}
+	_ resource.ResourceWithImportState      = &bgpRoutingResource{}
+	_ resource.ResourceWithConfigValidators = &bgpRoutingResource{}
+)
+
+func NewBgpRoutingResource() resource.Resource {
+	return &bgpRoutingResource{}
+}
+
+type bgpRoutingResource struct {
+	client *sase.Client
+}
+
+type bgpRoutingRsModel struct {
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
+
+	// Input.
+	Folder types.String `tfsdk:"folder"`
+
+	// Request body input.
+	// Ref: #/components/schemas/bgp-routing
+	AcceptRouteOverSC         types.Bool                                `tfsdk:"accept_route_over_s_c"`
+	AddHostRouteToIkePeer     types.Bool                                `tfsdk:"add_host_route_to_ike_peer"`
+	BackboneRouting           types.String                              `tfsdk:"backbone_routing"`
+	OutboundRoutesForServices []types.String                            `tfsdk:"outbound_routes_for_services"`
+	RoutingPreference         *bgpRoutingRsModelRoutingPreferenceObject `tfsdk:"routing_preference"`
+	WithdrawStaticRoute       types.Bool                                `tfsdk:"withdraw_static_route"`
+}
+
+type bgpRoutingRsModelRoutingPreferenceObject struct {
+	Default          types.Bool `tfsdk:"default"`
+	HotPotatoRouting types.Bool `tfsdk:"hot_potato_routing"`
+}
+
+// Metadata returns the data source type name.
+func (r *bgpRoutingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bgp_routing"
+}
+
+// Schema defines the schema for this listing data source.
+func (r *bgpRoutingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rsschema.Schema{
+		Description: "Manages the BGP routing settings of a folder. There is only one instance of these settings per folder, so deleting this resource restores the defaults instead.",
+
+		Attributes: map[string]rsschema.Attribute{
+			"id": rsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Input.
+			"folder": rsschema.StringAttribute{
+				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"accept_route_over_s_c": rsschema.BoolAttribute{
+				Description:         "The `accept_route_over_s_c` parameter.",
+				MarkdownDescription: "The `accept_route_over_s_c` parameter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					DefaultBool(false),
+				},
+			},
+			"add_host_route_to_ike_peer": rsschema.BoolAttribute{
+				Description:         "The `add_host_route_to_ike_peer` parameter.",
+				MarkdownDescription: "The `add_host_route_to_ike_peer` parameter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					DefaultBool(false),
+				},
+			},
+			"backbone_routing": rsschema.StringAttribute{
+				Description:         "The `backbone_routing` parameter. Default: `\"no-asymmetric-routing\"`. Value must be one of: `\"no-asymmetric-routing\"`, `\"asymmetric-routing-only\"`, `\"asymmetric-routing-with-load-share\"`.",
+				MarkdownDescription: "The `backbone_routing` parameter. Default: `\"no-asymmetric-routing\"`. Value must be one of: `\"no-asymmetric-routing\"`, `\"asymmetric-routing-only\"`, `\"asymmetric-routing-with-load-share\"`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					DefaultString("no-asymmetric-routing"),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("no-asymmetric-routing", "asymmetric-routing-only", "asymmetric-routing-with-load-share"),
+				},
+			},
+			"outbound_routes_for_services": rsschema.ListAttribute{
+				Description:         "The `outbound_routes_for_services` parameter. Each value must be an IPv4 or IPv6 prefix in CIDR notation.",
+				MarkdownDescription: "The `outbound_routes_for_services` parameter. Each value must be an IPv4 or IPv6 prefix in CIDR notation.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(IsIpPrefix()),
+				},
+			},
+			"routing_preference": rsschema.SingleNestedAttribute{
+				Description:         "The `routing_preference` parameter. If unset, the routing preference is left as is and not tracked.",
+				MarkdownDescription: "The `routing_preference` parameter. If unset, the routing preference is left as is and not tracked.",
+				Optional:            true,
+				Attributes: map[string]rsschema.Attribute{
+					"default": rsschema.BoolAttribute{
+						Description:         "The `default` parameter.",
+						MarkdownDescription: "The `default` parameter.",
+						Optional:            true,
+					},
+					"hot_potato_routing": rsschema.BoolAttribute{
+						Description:         "The `hot_potato_routing` parameter.",
+						MarkdownDescription: "The `hot_potato_routing` parameter.",
+						Optional:            true,
+					},
+				},
+			},
+			"withdraw_static_route": rsschema.BoolAttribute{
+				Description:         "The `withdraw_static_route` parameter.",
+				MarkdownDescription: "The `withdraw_static_route` parameter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					DefaultBool(false),
+				},
+			},
+		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_bgp_routing"),
+		},
+	}
+}
+
+// ConfigValidators returns the checks for mutually exclusive attributes.
+func (r *bgpRoutingResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		ExactlyOneOfNested(path.MatchRoot("routing_preference"), "default", "hot_potato_routing"),
+	}
+}
+
+// Configure prepares the struct.
+func (r *bgpRoutingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*sase.Client)
+}
+
+// Create resource
+func (r *bgpRoutingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state bgpRoutingRsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_bgp_routing", "create", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource create", map[string]any{
+		"terraform_provider_function": "Create",
+		"resource_name":               "sase_bgp_routing",
+		"folder":                      state.Folder.ValueString(),
+	})
+
+	// Prepare to create the config.
+	svc := fhcUKOQ.NewClient(r.client)
+	input := fhcUKOQ.UpdateInput{
+		Folder: state.Folder.ValueString(),
+	}
+	var var0 gJsQeVw.Config
+	var0.AcceptRouteOverSC = state.AcceptRouteOverSC.ValueBool()
+	var0.AddHostRouteToIkePeer = state.AddHostRouteToIkePeer.ValueBool()
+	var0.BackboneRouting = state.BackboneRouting.ValueString()
+	var0.OutboundRoutesForServices = DecodeStringSlice(state.OutboundRoutesForServices)
+	var var1 *gJsQeVw.RoutingPreferenceObject
+	if state.RoutingPreference != nil {
+		var1 = &gJsQeVw.RoutingPreferenceObject{}
+		if state.RoutingPreference.Default.ValueBool() {
+			var1.Default = struct{}{}
+		}
+		if state.RoutingPreference.HotPotatoRouting.ValueBool() {
+			var1.HotPotatoRouting = struct{}{}
+		}
+	}
+	var0.RoutingPreference = var1
+	var0.WithdrawStaticRoute = state.WithdrawStaticRoute.ValueBool()
+	input.Config = var0
+
+	// Perform the operation.
+	ans, err := svc.Update(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	state.Id = types.StringValue(input.Folder)
+	var var2 *bgpRoutingRsModelRoutingPreferenceObject
+	if ans.RoutingPreference != nil {
+		var2 = &bgpRoutingRsModelRoutingPreferenceObject{}
+		if ans.RoutingPreference.Default != nil {
+			var2.Default = types.BoolValue(true)
+		}
+		if ans.RoutingPreference.HotPotatoRouting != nil {
+			var2.HotPotatoRouting = types.BoolValue(true)
+		}
+	}
+	state.AcceptRouteOverSC = types.BoolValue(ans.AcceptRouteOverSC)
+	state.AddHostRouteToIkePeer = types.BoolValue(ans.AddHostRouteToIkePeer)
+	state.BackboneRouting = types.StringValue(ans.BackboneRouting)
+	state.OutboundRoutesForServices = EncodeStringSlice(ans.OutboundRoutesForServices)
+	if state.RoutingPreference != nil {
+		state.RoutingPreference = var2
+	}
+	state.WithdrawStaticRoute = types.BoolValue(ans.WithdrawStaticRoute)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read resource.
+func (r *bgpRoutingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var idType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &idType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	folder := idType.ValueString()
+
+	var state bgpRoutingRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The server always returns a routing preference, so it is only tracked
+	// if it was configured.
+	var prior types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("routing_preference"), &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_bgp_routing", "read", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource read", map[string]any{
+		"terraform_provider_function": "Read",
+		"resource_name":               "sase_bgp_routing",
+		"folder":                      folder,
+	})
+
+	// Prepare to read the config.
+	svc := fhcUKOQ.NewClient(r.client)
+	input := fhcUKOQ.ListInput{
+		Folder: folder,
+	}
+
+	// Perform the operation.
+	list, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
+		return
+	}
+	if len(list.Data) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	ans := list.Data[0]
+
+	// Store the answer to state.
+	state.Id = idType
+	state.Folder = types.StringValue(folder)
+	var var0 *bgpRoutingRsModelRoutingPreferenceObject
+	if ans.RoutingPreference != nil {
+		var0 = &bgpRoutingRsModelRoutingPreferenceObject{}
+		if ans.RoutingPreference.Default != nil {
+			var0.Default = types.BoolValue(true)
+		}
+		if ans.RoutingPreference.HotPotatoRouting != nil {
+			var0.HotPotatoRouting = types.BoolValue(true)
+		}
+	}
+	state.AcceptRouteOverSC = types.BoolValue(ans.AcceptRouteOverSC)
+	state.AddHostRouteToIkePeer = types.BoolValue(ans.AddHostRouteToIkePeer)
+	state.BackboneRouting = types.StringValue(ans.BackboneRouting)
+	state.OutboundRoutesForServices = EncodeStringSlice(ans.OutboundRoutesForServices)
+	if !prior.IsNull() {
+		state.RoutingPreference = var0
+	}
+	state.WithdrawStaticRoute = types.BoolValue(ans.WithdrawStaticRoute)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update resource.
+func (r *bgpRoutingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state bgpRoutingRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_bgp_routing", "update", plan.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource update", map[string]any{
+		"terraform_provider_function": "Update",
+		"resource_name":               "sase_bgp_routing",
+		"folder":                      state.Folder.ValueString(),
+	})
+
+	// Prepare to create the config.
+	svc := fhcUKOQ.NewClient(r.client)
+	input := fhcUKOQ.UpdateInput{
+		Folder: state.Folder.ValueString(),
+	}
+	var var0 gJsQeVw.Config
+	var0.AcceptRouteOverSC = plan.AcceptRouteOverSC.ValueBool()
+	var0.AddHostRouteToIkePeer = plan.AddHostRouteToIkePeer.ValueBool()
+	var0.BackboneRouting = plan.BackboneRouting.ValueString()
+	var0.OutboundRoutesForServices = DecodeStringSlice(plan.OutboundRoutesForServices)
+	var var1 *gJsQeVw.RoutingPreferenceObject
+	if plan.RoutingPreference != nil {
+		var1 = &gJsQeVw.RoutingPreferenceObject{}
+		if plan.RoutingPreference.Default.ValueBool() {
+			var1.Default = struct{}{}
+		}
+		if plan.RoutingPreference.HotPotatoRouting.ValueBool() {
+			var1.HotPotatoRouting = struct{}{}
+		}
+	}
+	var0.RoutingPreference = var1
+	var0.WithdrawStaticRoute = plan.WithdrawStaticRoute.ValueBool()
+	input.Config = var0
+
+	// Perform the operation.
+	ans, err := svc.Update(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
+	var var2 *bgpRoutingRsModelRoutingPreferenceObject
+	if ans.RoutingPreference != nil {
+		var2 = &bgpRoutingRsModelRoutingPreferenceObject{}
+		if ans.RoutingPreference.Default != nil {
+			var2.Default = types.BoolValue(true)
+		}
+		if ans.RoutingPreference.HotPotatoRouting != nil {
+			var2.HotPotatoRouting = types.BoolValue(true)
+		}
+	}
+	state.AcceptRouteOverSC = types.BoolValue(ans.AcceptRouteOverSC)
+	state.AddHostRouteToIkePeer = types.BoolValue(ans.AddHostRouteToIkePeer)
+	state.BackboneRouting = types.StringValue(ans.BackboneRouting)
+	state.OutboundRoutesForServices = EncodeStringSlice(ans.OutboundRoutesForServices)
+	state.RoutingPreference = nil
+	if plan.RoutingPreference != nil {
+		state.RoutingPreference = var2
+	}
+	state.WithdrawStaticRoute = types.BoolValue(ans.WithdrawStaticRoute)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete resource.
+func (r *bgpRoutingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var idType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &idType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_bgp_routing", "delete", timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource delete", map[string]any{
+		"terraform_provider_function": "Delete",
+		"resource_name":               "sase_bgp_routing",
+		"folder":                      idType.ValueString(),
+	})
+
+	// The settings can't be removed, so restore the defaults instead.
+	svc := fhcUKOQ.NewClient(r.client)
+	input := fhcUKOQ.UpdateInput{
+		Folder: idType.ValueString(),
+	}
+	var var0 gJsQeVw.Config
+	var0.BackboneRouting = "no-asymmetric-routing"
+	var0.RoutingPreference = &gJsQeVw.RoutingPreferenceObject{
+		Default: struct{}{},
+	}
+	input.Config = var0
+
+	// Perform the operation.
+	if _, err := svc.Update(ctx, input); err != nil {
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
+	}
+}
+
+// ImportState imports by either the resource ID, which is the folder, or by
+// location params, such as `folder=...`.
+func (r *bgpRoutingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	params, ok, err := ParseImportId(req.ID, "folder")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Store the canonical ID.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), params["folder"])...)
+}
@@ -0,0 +1,334 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/paloaltonetworks/sase-go"
+	qOFkTUB "github.com/paloaltonetworks/sase-go/netsec/service/v1/configversions"
+	wugpput "github.com/paloaltonetworks/sase-go/netsec/service/v1/jobs"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rsschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// JobPollInterval is how long to wait between job status checks.
+	JobPollInterval = 10 * time.Second
+
+	// Job status and result strings as returned by the jobs service.
+	JobStatusFinished = "FIN"
+	JobResultOk       = "OK"
+)
+
+// Resource.
+var (
+	_ resource.Resource              = &candidatePushResource{}
+	_ resource.ResourceWithConfigure = &candidatePushResource{}
+)
+
+func NewCandidatePushResource() resource.Resource {
+	return &candidatePushResource{}
+}
+
+type candidatePushResource struct {
+	client *sase.Client
+}
+
+type candidatePushRsModel struct {
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
+
+	// Input.
+	Folders     []types.String          `tfsdk:"folders"`
+	Description types.String            `tfsdk:"description"`
+	Triggers    map[string]types.String `tfsdk:"triggers"`
+
+	// Output.
+	JobId         types.String `tfsdk:"job_id"`
+	ConfigVersion types.String `tfsdk:"config_version"`
+}
+
+// Metadata returns the resource type name.
+func (r *candidatePushResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_candidate_push"
+}
+
+// Schema defines the schema for this resource.
+func (r *candidatePushResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rsschema.Schema{
+		Description: "Pushes the candidate config for the given folders and waits for the resulting jobs to finish.",
+
+		Attributes: map[string]rsschema.Attribute{
+			"id": rsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Input.
+			"folders": rsschema.ListAttribute{
+				Description:         "The folders to push. Value must be one of: `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				MarkdownDescription: "The folders to push. Value must be one of: `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(
+						stringvalidator.OneOf("Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
+					),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": rsschema.StringAttribute{
+				Description:         "The description of the push.",
+				MarkdownDescription: "The description of the push.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": rsschema.MapAttribute{
+				Description:         "Arbitrary map of values that, when changed, will force a new push. Reference attributes of the resources that this push depends on here.",
+				MarkdownDescription: "Arbitrary map of values that, when changed, will force a new push. Reference attributes of the resources that this push depends on here.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Output.
+			"job_id": rsschema.StringAttribute{
+				Description:         "The ID of the parent push job.",
+				MarkdownDescription: "The ID of the parent push job.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"config_version": rsschema.StringAttribute{
+				Description:         "The running config version after the push completed.",
+				MarkdownDescription: "The running config version after the push completed.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_candidate_push"),
+		},
+	}
+}
+
+// Configure prepares the struct.
+func (r *candidatePushResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*sase.Client)
+}
+
+// Create resource
+func (r *candidatePushResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state candidatePushRsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_candidate_push", "create", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource create", map[string]any{
+		"terraform_provider_function": "Create",
+		"resource_name":               "sase_candidate_push",
+		"folders":                     DecodeStringSlice(state.Folders),
+	})
+
+	// Prepare to push the candidate config.
+	svc := qOFkTUB.NewClient(r.client)
+	input := qOFkTUB.PushInput{
+		Folders:     DecodeStringSlice(state.Folders),
+		Description: state.Description.ValueString(),
+	}
+
+	// Perform the operation.
+	ans, err := svc.Push(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in candidate push", OperationError(ctx, err))
+		return
+	}
+	if !ans.Success {
+		resp.Diagnostics.AddError("Error in candidate push", ans.Message)
+		return
+	}
+
+	// Wait for the parent job and all of its children to finish.
+	if err = WaitForJob(ctx, r.client, ans.JobId); err != nil {
+		resp.Diagnostics.AddError("Error in candidate push job", OperationError(ctx, err))
+		return
+	}
+
+	// Retrieve the resulting config version.
+	ver, err := svc.Read(ctx, qOFkTUB.ReadInput{Version: "running"})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading running config version", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	state.Id = types.StringValue(ans.JobId)
+	state.JobId = types.StringValue(ans.JobId)
+	state.ConfigVersion = types.StringValue(ver.Version)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read resource.
+func (r *candidatePushResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// A push is a one time operation, so there is nothing to refresh.
+	var state candidatePushRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update resource.
+func (r *candidatePushResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All inputs require replacement, so this just saves the plan.
+	var plan candidatePushRsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete resource.
+func (r *candidatePushResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Pushed config cannot be un-pushed, so just remove it from state.
+	tflog.Info(ctx, "performing resource delete", map[string]any{
+		"terraform_provider_function": "Delete",
+		"resource_name":               "sase_candidate_push",
+	})
+}
+
+// WaitForJob polls the jobs service until the given job and all of its child
+// jobs have finished, returning an error if any of them failed.
+func WaitForJob(ctx context.Context, client *sase.Client, jobId string) error {
+	svc := wugpput.NewClient(client)
+
+	for {
+		job, err := svc.Read(ctx, wugpput.ReadInput{JobId: jobId})
+		if err != nil {
+			return err
+		}
+
+		tflog.Debug(ctx, "polled job status", map[string]any{
+			"job_id":     jobId,
+			"status_str": job.StatusStr,
+			"result_str": job.ResultStr,
+			"percent":    job.Percent,
+		})
+
+		if job.StatusStr == JobStatusFinished {
+			if job.ResultStr != JobResultOk {
+				return jobError(jobId, job.ResultStr, job.Details)
+			}
+			break
+		}
+
+		if err = sleepContext(ctx, JobPollInterval); err != nil {
+			return err
+		}
+	}
+
+	// The parent job finishing does not mean the children are done.
+	for {
+		done, err := childJobsDone(ctx, svc, jobId)
+		if err != nil || done {
+			return err
+		}
+
+		if err = sleepContext(ctx, JobPollInterval); err != nil {
+			return err
+		}
+	}
+}
+
+// childJobsDone returns if all child jobs of the given job have finished, or
+// an error if any of them failed.
+func childJobsDone(ctx context.Context, svc *wugpput.Client, jobId string) (bool, error) {
+	list, err := svc.List(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	done := true
+	for _, job := range list.Data {
+		if job.ParentId != jobId {
+			continue
+		}
+		if job.StatusStr != JobStatusFinished {
+			done = false
+			continue
+		}
+		if job.ResultStr != JobResultOk {
+			return false, jobError(job.ObjectId, job.ResultStr, job.Details)
+		}
+	}
+
+	return done, nil
+}
+
+func jobError(jobId, result, details string) error {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("job %s finished with result %q", jobId, result))
+	if details != "" {
+		b.WriteString(": ")
+		b.WriteString(details)
+	}
+
+	return errors.New(b.String())
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,403 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/paloaltonetworks/sase-go"
+	"github.com/paloaltonetworks/sase-go/api"
+	kmfIrpR "github.com/paloaltonetworks/sase-go/netsec/service/v1/certificates"
+	jOaWaMY "github.com/paloaltonetworks/sase-go/netsec/service/v1/trustedcertificateauthorities"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// CertificateTimeLayouts are the formats that certificate validity timestamps
+// are parsed with, in order.
+var CertificateTimeLayouts = []string{
+	"Jan _2 15:04:05 2006 MST",
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006/01/02 15:04:05",
+}
+
+// Data source.
+var (
+	_ datasource.DataSource              = &certificateExpiryDataSource{}
+	_ datasource.DataSourceWithConfigure = &certificateExpiryDataSource{}
+)
+
+func NewCertificateExpiryDataSource() datasource.DataSource {
+	return &certificateExpiryDataSource{}
+}
+
+type certificateExpiryDataSource struct {
+	client *sase.Client
+}
+
+type certificateExpiryDsModel struct {
+	Id types.String `tfsdk:"id"`
+
+	// Input.
+	Folders           []types.String `tfsdk:"folders"`
+	WithinDays        types.Int64    `tfsdk:"within_days"`
+	IncludeTrustedCas types.Bool     `tfsdk:"include_trusted_cas"`
+	Warn              types.Bool     `tfsdk:"warn"`
+
+	// Output.
+	Certificates []certificateExpiryDsModelCertificatesObject `tfsdk:"certificates"`
+}
+
+type certificateExpiryDsModelCertificatesObject struct {
+	Folder         types.String `tfsdk:"folder"`
+	Source         types.String `tfsdk:"source"`
+	ObjectId       types.String `tfsdk:"object_id"`
+	Name           types.String `tfsdk:"name"`
+	CommonName     types.String `tfsdk:"common_name"`
+	Issuer         types.String `tfsdk:"issuer"`
+	Subject        types.String `tfsdk:"subject"`
+	NotValidBefore types.String `tfsdk:"not_valid_before"`
+	NotValidAfter  types.String `tfsdk:"not_valid_after"`
+	DaysRemaining  types.Int64  `tfsdk:"days_remaining"`
+	Expired        types.Bool   `tfsdk:"expired"`
+}
+
+// Metadata returns the data source type name.
+func (d *certificateExpiryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate_expiry"
+}
+
+// Schema defines the schema for this data source.
+func (d *certificateExpiryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Retrieves the certificates that have expired or that expire within the given number of days, soonest first.",
+
+		Attributes: map[string]dsschema.Attribute{
+			"id": dsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+			},
+
+			// Input.
+			"folders": dsschema.ListAttribute{
+				Description:         "The folders to check. If unset, all folders are checked. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				MarkdownDescription: "The folders to check. If unset, all folders are checked. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(
+						stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
+					),
+				},
+			},
+			"within_days": dsschema.Int64Attribute{
+				Description:         "Certificates that expire within this many days are returned. Value must be at least 0. Default: `30`.",
+				MarkdownDescription: "Certificates that expire within this many days are returned. Value must be at least 0. Default: `30`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"include_trusted_cas": dsschema.BoolAttribute{
+				Description:         "Also check the trusted certificate authorities.",
+				MarkdownDescription: "Also check the trusted certificate authorities.",
+				Optional:            true,
+			},
+			"warn": dsschema.BoolAttribute{
+				Description:         "Add a warning diagnostic for each certificate returned, so that they show up in the plan.",
+				MarkdownDescription: "Add a warning diagnostic for each certificate returned, so that they show up in the plan.",
+				Optional:            true,
+			},
+
+			// Output.
+			"certificates": dsschema.ListNestedAttribute{
+				Description:         "The certificates that have expired or that expire within the window, soonest first.",
+				MarkdownDescription: "The certificates that have expired or that expire within the window, soonest first.",
+				Computed:            true,
+				NestedObject: dsschema.NestedAttributeObject{
+					Attributes: map[string]dsschema.Attribute{
+						"folder": dsschema.StringAttribute{
+							Description:         "The folder of the certificate.",
+							MarkdownDescription: "The folder of the certificate.",
+							Computed:            true,
+						},
+						"source": dsschema.StringAttribute{
+							Description:         "Where the certificate was found. Value is one of: `\"certificate\"`, `\"trusted_ca\"`.",
+							MarkdownDescription: "Where the certificate was found. Value is one of: `\"certificate\"`, `\"trusted_ca\"`.",
+							Computed:            true,
+						},
+						"object_id": dsschema.StringAttribute{
+							Description:         "The uuid of the certificate.",
+							MarkdownDescription: "The uuid of the certificate.",
+							Computed:            true,
+						},
+						"name": dsschema.StringAttribute{
+							Description:         "The name of the certificate. Only set for trusted certificate authorities.",
+							MarkdownDescription: "The name of the certificate. Only set for trusted certificate authorities.",
+							Computed:            true,
+						},
+						"common_name": dsschema.StringAttribute{
+							Description:         "The `common_name` parameter.",
+							MarkdownDescription: "The `common_name` parameter.",
+							Computed:            true,
+						},
+						"issuer": dsschema.StringAttribute{
+							Description:         "The `issuer` parameter.",
+							MarkdownDescription: "The `issuer` parameter.",
+							Computed:            true,
+						},
+						"subject": dsschema.StringAttribute{
+							Description:         "The `subject` parameter.",
+							MarkdownDescription: "The `subject` parameter.",
+							Computed:            true,
+						},
+						"not_valid_before": dsschema.StringAttribute{
+							Description:         "The start of the validity period as an RFC3339 timestamp.",
+							MarkdownDescription: "The start of the validity period as an RFC3339 timestamp.",
+							Computed:            true,
+						},
+						"not_valid_after": dsschema.StringAttribute{
+							Description:         "The end of the validity period as an RFC3339 timestamp.",
+							MarkdownDescription: "The end of the validity period as an RFC3339 timestamp.",
+							Computed:            true,
+						},
+						"days_remaining": dsschema.Int64Attribute{
+							Description:         "The number of whole days until the certificate expires. Negative if it has already expired.",
+							MarkdownDescription: "The number of whole days until the certificate expires. Negative if it has already expired.",
+							Computed:            true,
+						},
+						"expired": dsschema.BoolAttribute{
+							Description:         "Whether the certificate has already expired.",
+							MarkdownDescription: "Whether the certificate has already expired.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure prepares the struct.
+func (d *certificateExpiryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*sase.Client)
+}
+
+func (d *certificateExpiryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state certificateExpiryDsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folders := DecodeStringSlice(state.Folders)
+	if len(folders) == 0 {
+		folders = []string{"Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"}
+	}
+	withinDays := int64(30)
+	if !state.WithinDays.IsNull() {
+		withinDays = state.WithinDays.ValueInt64()
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing data source read", map[string]any{
+		"data_source_name":            "sase_certificate_expiry",
+		"terraform_provider_function": "Read",
+		"folders":                     folders,
+		"within_days":                 withinDays,
+		"include_trusted_cas":         state.IncludeTrustedCas.ValueBool(),
+	})
+
+	now := time.Now().UTC()
+	cutoff := now.Add(time.Duration(withinDays) * 24 * time.Hour)
+	var unparsed int
+	var ans []certificateExpiryDsModelCertificatesObject
+
+	// addCertificate adds the certificate if it expires before the cutoff.
+	addCertificate := func(folder, source, objectId, name, commonName, issuer, subject, expiryEpoch, notValidBefore, notValidAfter string) {
+		expiry, ok := ParseCertificateExpiry(expiryEpoch, notValidAfter)
+		if !ok {
+			unparsed++
+			tflog.Warn(ctx, "unable to parse certificate expiry", map[string]any{
+				"folder":          folder,
+				"object_id":       objectId,
+				"expiry_epoch":    expiryEpoch,
+				"not_valid_after": notValidAfter,
+			})
+			return
+		}
+		if expiry.After(cutoff) {
+			return
+		}
+
+		var var0 certificateExpiryDsModelCertificatesObject
+		var0.Folder = types.StringValue(folder)
+		var0.Source = types.StringValue(source)
+		var0.ObjectId = types.StringValue(objectId)
+		var0.Name = types.StringNull()
+		if name != "" {
+			var0.Name = types.StringValue(name)
+		}
+		var0.CommonName = types.StringValue(commonName)
+		var0.Issuer = types.StringValue(issuer)
+		var0.Subject = types.StringValue(subject)
+		var0.NotValidBefore = types.StringNull()
+		if t, ok := ParseCertificateTime(notValidBefore); ok {
+			var0.NotValidBefore = types.StringValue(t.Format(time.RFC3339))
+		}
+		var0.NotValidAfter = types.StringValue(expiry.Format(time.RFC3339))
+		var0.DaysRemaining = types.Int64Value(int64(math.Floor(expiry.Sub(now).Hours() / 24)))
+		var0.Expired = types.BoolValue(!expiry.After(now))
+		ans = append(ans, var0)
+	}
+
+	// Check the certificates.
+	svc := kmfIrpR.NewClient(d.client)
+	for _, folder := range folders {
+		input := kmfIrpR.ListInput{
+			Folder: folder,
+			Limit:  api.Int(DefaultPageSize),
+		}
+		list, err := svc.List(ctx, input)
+		if err != nil {
+			resp.Diagnostics.AddError("Error getting listing", OperationError(ctx, err))
+			return
+		}
+		resp.Diagnostics.Append(FetchAllPages(nil, int64(len(list.Data)), list.Total, 0, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			list.Data = append(list.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for _, x := range list.Data {
+			addCertificate(folder, "certificate", x.ObjectId, "", x.CommonName, x.Issuer, x.Subject, x.ExpiryEpoch, x.NotValidBefore, x.NotValidAfter)
+		}
+	}
+
+	// Check the trusted certificate authorities.
+	if state.IncludeTrustedCas.ValueBool() {
+		svc := jOaWaMY.NewClient(d.client)
+		for _, folder := range folders {
+			input := jOaWaMY.ListInput{
+				Folder: folder,
+				Limit:  api.Int(DefaultPageSize),
+			}
+			list, err := svc.List(ctx, input)
+			if err != nil {
+				resp.Diagnostics.AddError("Error getting listing", OperationError(ctx, err))
+				return
+			}
+			resp.Diagnostics.Append(FetchAllPages(nil, int64(len(list.Data)), list.Total, 0, func(offset int64) (int, error) {
+				pageInput := input
+				pageInput.Offset = api.Int(offset)
+				page, err := svc.List(ctx, pageInput)
+				if err != nil {
+					return 0, err
+				}
+				list.Data = append(list.Data, page.Data...)
+				return len(page.Data), nil
+			})...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			for _, x := range list.Data {
+				addCertificate(folder, "trusted_ca", x.ObjectId, x.Name, x.CommonName, x.Issuer, x.Subject, x.ExpiryEpoch, x.NotValidBefore, x.NotValidAfter)
+			}
+		}
+	}
+
+	sort.SliceStable(ans, func(i, j int) bool {
+		return ans[i].DaysRemaining.ValueInt64() < ans[j].DaysRemaining.ValueInt64()
+	})
+
+	if unparsed != 0 {
+		resp.Diagnostics.AddWarning(
+			"Unknown certificate expiry",
+			fmt.Sprintf("The expiry of %d certificate(s) could not be parsed, so they were not checked.", unparsed),
+		)
+	}
+	if state.Warn.ValueBool() {
+		for _, x := range ans {
+			summary := "Certificate expiring soon"
+			if x.Expired.ValueBool() {
+				summary = "Certificate expired"
+			}
+			label := x.Name.ValueString()
+			if label == "" {
+				label = x.CommonName.ValueString()
+			}
+			resp.Diagnostics.AddWarning(
+				summary,
+				fmt.Sprintf("The %s %q in folder %q expires at %s (%d days remaining).", strings.ReplaceAll(x.Source.ValueString(), "_", " "), label, x.Folder.ValueString(), x.NotValidAfter.ValueString(), x.DaysRemaining.ValueInt64()),
+			)
+		}
+	}
+
+	// Store the answer to state.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(strings.Join(folders, ","))
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(strconv.FormatInt(withinDays, 10))
+	state.Id = types.StringValue(idBuilder.String())
+	state.Certificates = ans
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ParseCertificateExpiry returns the expiry of a certificate, preferring the
+// epoch seconds over the not_valid_after text.
+func ParseCertificateExpiry(epoch, notValidAfter string) (time.Time, bool) {
+	if epoch != "" {
+		if v, err := strconv.ParseInt(epoch, 10, 64); err == nil {
+			return time.Unix(v, 0).UTC(), true
+		}
+	}
+
+	return ParseCertificateTime(notValidAfter)
+}
+
+// ParseCertificateTime parses a certificate validity timestamp using the
+// CertificateTimeLayouts.
+func ParseCertificateTime(v string) (time.Time, bool) {
+	v = strings.Join(strings.Fields(v), " ")
+	if v == "" {
+		return time.Time{}, false
+	}
+
+	for _, layout := range CertificateTimeLayouts {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t.UTC(), true
+		}
+	}
+
+	return time.Time{}, false
+}
@@ -0,0 +1,479 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/paloaltonetworks/sase-go"
+	"github.com/paloaltonetworks/sase-go/api"
+	kmfIrpR "github.com/paloaltonetworks/sase-go/netsec/service/v1/certificates"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rsschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Resource.
+var (
+	_ resource.Resource                     = &certificateGenerateResource{}
+	_ resource.ResourceWithConfigure        = &certificateGenerateResource{}
+	_ resource.ResourceWithConfigValidators = &certificateGenerateResource{}
+)
+
+func NewCertificateGenerateResource() resource.Resource {
+	return &certificateGenerateResource{}
+}
+
+type certificateGenerateResource struct {
+	client *sase.Client
+}
+
+type certificateGenerateRsModel struct {
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
+
+	// Input.
+	Folder                 types.String                         `tfsdk:"folder"`
+	Name                   types.String                         `tfsdk:"name"`
+	CommonName             types.String                         `tfsdk:"common_name"`
+	SignedBy               types.String                         `tfsdk:"signed_by"`
+	IsCertificateAuthority types.Bool                           `tfsdk:"is_certificate_authority"`
+	Algorithm              *certificateGenerateRsModelAlgorithm `tfsdk:"algorithm"`
+	Digest                 types.String                         `tfsdk:"digest"`
+	DayTillExpiration      types.Int64                          `tfsdk:"day_till_expiration"`
+	AlternateEmail         []types.String                       `tfsdk:"alternate_email"`
+	Hostname               []types.String                       `tfsdk:"hostname"`
+	Ip                     []types.String                       `tfsdk:"ip"`
+
+	// Output.
+	ObjectId       types.String `tfsdk:"object_id"`
+	Ca             types.Bool   `tfsdk:"ca"`
+	ExpiryEpoch    types.String `tfsdk:"expiry_epoch"`
+	Issuer         types.String `tfsdk:"issuer"`
+	NotValidAfter  types.String `tfsdk:"not_valid_after"`
+	NotValidBefore types.String `tfsdk:"not_valid_before"`
+	PublicKey      types.String `tfsdk:"public_key"`
+	Subject        types.String `tfsdk:"subject"`
+}
+
+type certificateGenerateRsModelAlgorithm struct {
+	RsaNumberOfBits   types.Int64 `tfsdk:"rsa_number_of_bits"`
+	EcdsaNumberOfBits types.Int64 `tfsdk:"ecdsa_number_of_bits"`
+}
+
+// Metadata returns the data source type name.
+func (r *certificateGenerateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate_generate"
+}
+
+// Schema defines the schema for this listing data source.
+func (r *certificateGenerateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rsschema.Schema{
+		Description: "Generates a certificate that is either self-signed or signed by a CA certificate. Changing any input generates a new certificate.",
+
+		Attributes: map[string]rsschema.Attribute{
+			"id": rsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Input.
+			"folder": rsschema.StringAttribute{
+				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": rsschema.StringAttribute{
+				Description:         "The name of the certificate. String length must be at most 63.",
+				MarkdownDescription: "The name of the certificate. String length must be at most 63.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(63),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"common_name": rsschema.StringAttribute{
+				Description:         "The common name of the certificate. String length must be at most 64.",
+				MarkdownDescription: "The common name of the certificate. String length must be at most 64.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(64),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"signed_by": rsschema.StringAttribute{
+				Description:         "The name of the CA certificate that signs this certificate. If unset, the certificate is self-signed. The certificate must exist in the folder or in a folder that it inherits from, such as `\"Shared\"`.",
+				MarkdownDescription: "The name of the CA certificate that signs this certificate. If unset, the certificate is self-signed. The certificate must exist in the folder or in a folder that it inherits from, such as `\"Shared\"`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_certificate_authority": rsschema.BoolAttribute{
+				Description:         "Whether the certificate can sign other certificates.",
+				MarkdownDescription: "Whether the certificate can sign other certificates.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					DefaultBool(false),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"algorithm": rsschema.SingleNestedAttribute{
+				Description:         "The key algorithm of the certificate.",
+				MarkdownDescription: "The key algorithm of the certificate.",
+				Required:            true,
+				Attributes: map[string]rsschema.Attribute{
+					"rsa_number_of_bits": rsschema.Int64Attribute{
+						Description:         "The RSA key size. Value must be one of: `1024`, `2048`, `3072`, `4096`.",
+						MarkdownDescription: "The RSA key size. Value must be one of: `1024`, `2048`, `3072`, `4096`.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.OneOf(1024, 2048, 3072, 4096),
+						},
+					},
+					"ecdsa_number_of_bits": rsschema.Int64Attribute{
+						Description:         "The ECDSA key size. Value must be one of: `256`, `384`, `521`.",
+						MarkdownDescription: "The ECDSA key size. Value must be one of: `256`, `384`, `521`.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.OneOf(256, 384, 521),
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"digest": rsschema.StringAttribute{
+				Description:         "The digest algorithm. Value must be one of: `\"sha1\"`, `\"sha256\"`, `\"sha384\"`, `\"sha512\"`. Default: `\"sha256\"`.",
+				MarkdownDescription: "The digest algorithm. Value must be one of: `\"sha1\"`, `\"sha256\"`, `\"sha384\"`, `\"sha512\"`. Default: `\"sha256\"`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("sha1", "sha256", "sha384", "sha512"),
+				},
+				PlanModifiers: []planmodifier.String{
+					DefaultString("sha256"),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"day_till_expiration": rsschema.Int64Attribute{
+				Description:         "The number of days until the certificate expires. Value must be between 1 and 7300. Default: `365`.",
+				MarkdownDescription: "The number of days until the certificate expires. Value must be between 1 and 7300. Default: `365`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 7300),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					DefaultInt64(365),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"alternate_email": rsschema.ListAttribute{
+				Description:         "The email addresses in the subject alternative name.",
+				MarkdownDescription: "The email addresses in the subject alternative name.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"hostname": rsschema.ListAttribute{
+				Description:         "The hostnames in the subject alternative name.",
+				MarkdownDescription: "The hostnames in the subject alternative name.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(IsFqdn()),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip": rsschema.ListAttribute{
+				Description:         "The IP addresses in the subject alternative name.",
+				MarkdownDescription: "The IP addresses in the subject alternative name.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(IsIpAddress()),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Output.
+			"object_id": rsschema.StringAttribute{
+				Description:         "The uuid of the certificate.",
+				MarkdownDescription: "The uuid of the certificate.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ca": rsschema.BoolAttribute{
+				Description:         "The `ca` parameter.",
+				MarkdownDescription: "The `ca` parameter.",
+				Computed:            true,
+			},
+			"expiry_epoch": rsschema.StringAttribute{
+				Description:         "The `expiry_epoch` parameter.",
+				MarkdownDescription: "The `expiry_epoch` parameter.",
+				Computed:            true,
+			},
+			"issuer": rsschema.StringAttribute{
+				Description:         "The `issuer` parameter.",
+				MarkdownDescription: "The `issuer` parameter.",
+				Computed:            true,
+			},
+			"not_valid_after": rsschema.StringAttribute{
+				Description:         "The `not_valid_after` parameter.",
+				MarkdownDescription: "The `not_valid_after` parameter.",
+				Computed:            true,
+			},
+			"not_valid_before": rsschema.StringAttribute{
+				Description:         "The `not_valid_before` parameter.",
+				MarkdownDescription: "The `not_valid_before` parameter.",
+				Computed:            true,
+			},
+			"public_key": rsschema.StringAttribute{
+				Description:         "The `public_key` parameter.",
+				MarkdownDescription: "The `public_key` parameter.",
+				Computed:            true,
+			},
+			"subject": rsschema.StringAttribute{
+				Description:         "The `subject` parameter.",
+				MarkdownDescription: "The `subject` parameter.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_certificate_generate"),
+		},
+	}
+}
+
+// ConfigValidators returns the checks for mutually exclusive attributes.
+func (r *certificateGenerateResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		ExactlyOneOfNested(
+			path.MatchRoot("algorithm"),
+			"rsa_number_of_bits",
+			"ecdsa_number_of_bits",
+		),
+	}
+}
+
+// Configure prepares the struct.
+func (r *certificateGenerateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*sase.Client)
+}
+
+// Create resource.
+func (r *certificateGenerateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state certificateGenerateRsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_certificate_generate", "create", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource create", map[string]any{
+		"terraform_provider_function": "Create",
+		"resource_name":               "sase_certificate_generate",
+		"folder":                      state.Folder.ValueString(),
+		"name":                        state.Name.ValueString(),
+		"signed_by":                   state.SignedBy.ValueString(),
+	})
+
+	CheckReference(ctx, &resp.Diagnostics, r.client, path.Root("signed_by"), "certificate", state.Folder.ValueString(), state.SignedBy, CertificateExists)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Prepare to generate the certificate.
+	svc := kmfIrpR.NewClient(r.client)
+	input := kmfIrpR.GenerateInput{
+		Folder:                 state.Folder.ValueString(),
+		CertificateName:        state.Name.ValueString(),
+		CommonName:             state.CommonName.ValueString(),
+		SignedBy:               state.SignedBy.ValueString(),
+		IsCertificateAuthority: state.IsCertificateAuthority.ValueBool(),
+		Digest:                 state.Digest.ValueString(),
+		DayTillExpiration:      state.DayTillExpiration.ValueInt64(),
+		AlternateEmail:         DecodeStringSlice(state.AlternateEmail),
+		Hostname:               DecodeStringSlice(state.Hostname),
+		Ip:                     DecodeStringSlice(state.Ip),
+	}
+	if !state.Algorithm.RsaNumberOfBits.IsNull() {
+		input.RsaNumberOfBits = api.Int(state.Algorithm.RsaNumberOfBits.ValueInt64())
+	}
+	if !state.Algorithm.EcdsaNumberOfBits.IsNull() {
+		input.EcdsaNumberOfBits = api.Int(state.Algorithm.EcdsaNumberOfBits.ValueInt64())
+	}
+
+	// Perform the operation.
+	ans, err := svc.Generate(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(input.Folder)
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(ans.ObjectId)
+	state.Id = types.StringValue(idBuilder.String())
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.Ca = types.BoolValue(ans.Ca)
+	state.ExpiryEpoch = types.StringValue(ans.ExpiryEpoch)
+	state.Issuer = types.StringValue(ans.Issuer)
+	state.NotValidAfter = types.StringValue(ans.NotValidAfter)
+	state.NotValidBefore = types.StringValue(ans.NotValidBefore)
+	state.PublicKey = types.StringValue(ans.PublicKey)
+	state.Subject = types.StringValue(ans.Subject)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read resource.
+func (r *certificateGenerateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The generation parameters are not returned, so they are kept as they
+	// are in the state.
+	var state certificateGenerateRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokens := strings.Split(state.Id.ValueString(), IdSeparator)
+	if len(tokens) != 2 {
+		resp.Diagnostics.AddError("Error in resource ID format", "Expected 2 tokens")
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_certificate_generate", "read", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource read", map[string]any{
+		"terraform_provider_function": "Read",
+		"resource_name":               "sase_certificate_generate",
+		"locMap":                      map[string]int{"Folder": 0, "ObjectId": 1},
+		"tokens":                      tokens,
+	})
+
+	// Prepare to read the config.
+	svc := kmfIrpR.NewClient(r.client)
+	input := kmfIrpR.ReadInput{
+		ObjectId: tokens[1],
+	}
+
+	// Perform the operation.
+	ans, err := svc.Read(ctx, input)
+	if err != nil {
+		if IsObjectNotFound(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
+		}
+		return
+	}
+
+	// Store the answer to state.
+	state.Folder = types.StringValue(tokens[0])
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.Ca = types.BoolValue(ans.Ca)
+	state.ExpiryEpoch = types.StringValue(ans.ExpiryEpoch)
+	state.Issuer = types.StringValue(ans.Issuer)
+	state.NotValidAfter = types.StringValue(ans.NotValidAfter)
+	state.NotValidBefore = types.StringValue(ans.NotValidBefore)
+	state.PublicKey = types.StringValue(ans.PublicKey)
+	state.Subject = types.StringValue(ans.Subject)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update resource.
+func (r *certificateGenerateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All inputs require replacement, so only the timeouts can change here.
+	var plan, state certificateGenerateRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Timeouts = plan.Timeouts
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete resource.
+func (r *certificateGenerateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state certificateGenerateRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_certificate_generate", "delete", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource delete", map[string]any{
+		"terraform_provider_function": "Delete",
+		"resource_name":               "sase_certificate_generate",
+		"object_id":                   state.ObjectId.ValueString(),
+	})
+
+	svc := kmfIrpR.NewClient(r.client)
+	input := kmfIrpR.DeleteInput{
+		ObjectId: state.ObjectId.ValueString(),
+	}
+
+	// Perform the operation.
+	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
+	}
+}
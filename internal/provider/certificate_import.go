@@ -0,0 +1,410 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/paloaltonetworks/sase-go"
+	kmfIrpR "github.com/paloaltonetworks/sase-go/netsec/service/v1/certificates"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rsschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Resource.
+var (
+	_ resource.Resource                   = &certificateImportResource{}
+	_ resource.ResourceWithConfigure      = &certificateImportResource{}
+	_ resource.ResourceWithValidateConfig = &certificateImportResource{}
+)
+
+func NewCertificateImportResource() resource.Resource {
+	return &certificateImportResource{}
+}
+
+type certificateImportResource struct {
+	client *sase.Client
+}
+
+type certificateImportRsModel struct {
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
+
+	// Input.
+	Folder          types.String `tfsdk:"folder"`
+	Name            types.String `tfsdk:"name"`
+	Format          types.String `tfsdk:"format"`
+	CertificateFile types.String `tfsdk:"certificate_file"`
+	KeyFile         types.String `tfsdk:"key_file"`
+	Passphrase      types.String `tfsdk:"passphrase"`
+
+	// Output.
+	ObjectId       types.String `tfsdk:"object_id"`
+	Algorithm      types.String `tfsdk:"algorithm"`
+	Ca             types.Bool   `tfsdk:"ca"`
+	CommonName     types.String `tfsdk:"common_name"`
+	ExpiryEpoch    types.String `tfsdk:"expiry_epoch"`
+	Issuer         types.String `tfsdk:"issuer"`
+	NotValidAfter  types.String `tfsdk:"not_valid_after"`
+	NotValidBefore types.String `tfsdk:"not_valid_before"`
+	PublicKey      types.String `tfsdk:"public_key"`
+	Subject        types.String `tfsdk:"subject"`
+}
+
+// Metadata returns the data source type name.
+func (r *certificateImportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate_import"
+}
+
+// Schema defines the schema for this listing data source.
+func (r *certificateImportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rsschema.Schema{
+		Description: "Imports a certificate, and optionally its private key. Changing any input imports a new certificate.",
+
+		Attributes: map[string]rsschema.Attribute{
+			"id": rsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Input.
+			"folder": rsschema.StringAttribute{
+				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": rsschema.StringAttribute{
+				Description:         "The name of the certificate. String length must be at most 63.",
+				MarkdownDescription: "The name of the certificate. String length must be at most 63.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(63),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"format": rsschema.StringAttribute{
+				Description:         "The format of `certificate_file`. Value must be one of: `\"pem\"`, `\"pkcs12\"`. Default: `\"pem\"`.",
+				MarkdownDescription: "The format of `certificate_file`. Value must be one of: `\"pem\"`, `\"pkcs12\"`. Default: `\"pem\"`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("pem", "pkcs12"),
+				},
+				PlanModifiers: []planmodifier.String{
+					DefaultString("pem"),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate_file": rsschema.StringAttribute{
+				Description:         "The certificate. For `\"pem\"`, this is the PEM encoded certificate. For `\"pkcs12\"`, this is the base64 encoded bundle, which also holds the private key.",
+				MarkdownDescription: "The certificate. For `\"pem\"`, this is the PEM encoded certificate. For `\"pkcs12\"`, this is the base64 encoded bundle, which also holds the private key.",
+				Required:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key_file": rsschema.StringAttribute{
+				Description:         "The PEM encoded private key. Only valid if `format` is `\"pem\"`.",
+				MarkdownDescription: "The PEM encoded private key. Only valid if `format` is `\"pem\"`.",
+				Optional:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"passphrase": rsschema.StringAttribute{
+				Description:         "The passphrase that the private key or the PKCS12 bundle is encrypted with.",
+				MarkdownDescription: "The passphrase that the private key or the PKCS12 bundle is encrypted with.",
+				Optional:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Output.
+			"object_id": rsschema.StringAttribute{
+				Description:         "The uuid of the certificate.",
+				MarkdownDescription: "The uuid of the certificate.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"algorithm": rsschema.StringAttribute{
+				Description:         "The `algorithm` parameter.",
+				MarkdownDescription: "The `algorithm` parameter.",
+				Computed:            true,
+			},
+			"ca": rsschema.BoolAttribute{
+				Description:         "The `ca` parameter.",
+				MarkdownDescription: "The `ca` parameter.",
+				Computed:            true,
+			},
+			"common_name": rsschema.StringAttribute{
+				Description:         "The `common_name` parameter.",
+				MarkdownDescription: "The `common_name` parameter.",
+				Computed:            true,
+			},
+			"expiry_epoch": rsschema.StringAttribute{
+				Description:         "The `expiry_epoch` parameter.",
+				MarkdownDescription: "The `expiry_epoch` parameter.",
+				Computed:            true,
+			},
+			"issuer": rsschema.StringAttribute{
+				Description:         "The `issuer` parameter.",
+				MarkdownDescription: "The `issuer` parameter.",
+				Computed:            true,
+			},
+			"not_valid_after": rsschema.StringAttribute{
+				Description:         "The `not_valid_after` parameter.",
+				MarkdownDescription: "The `not_valid_after` parameter.",
+				Computed:            true,
+			},
+			"not_valid_before": rsschema.StringAttribute{
+				Description:         "The `not_valid_before` parameter.",
+				MarkdownDescription: "The `not_valid_before` parameter.",
+				Computed:            true,
+			},
+			"public_key": rsschema.StringAttribute{
+				Description:         "The `public_key` parameter.",
+				MarkdownDescription: "The `public_key` parameter.",
+				Computed:            true,
+			},
+			"subject": rsschema.StringAttribute{
+				Description:         "The `subject` parameter.",
+				MarkdownDescription: "The `subject` parameter.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_certificate_import"),
+		},
+	}
+}
+
+// Configure prepares the struct.
+func (r *certificateImportResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*sase.Client)
+}
+
+// ValidateConfig checks that the private key is only given separately for
+// PEM certificates.
+func (r *certificateImportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var format, certificateFile, keyFile types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("format"), &format)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("certificate_file"), &certificateFile)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("key_file"), &keyFile)...)
+	if resp.Diagnostics.HasError() || format.IsUnknown() {
+		return
+	}
+
+	if format.ValueString() == "pkcs12" {
+		if !keyFile.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("key_file"),
+				"Invalid Attribute Combination",
+				"key_file can't be configured when format is \"pkcs12\", as the bundle holds the private key.",
+			)
+		}
+		return
+	}
+
+	if !certificateFile.IsNull() && !certificateFile.IsUnknown() && !strings.Contains(certificateFile.ValueString(), "-----BEGIN CERTIFICATE-----") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("certificate_file"),
+			"Invalid Certificate",
+			"certificate_file must be a PEM encoded certificate when format is \"pem\".",
+		)
+	}
+}
+
+// Create resource.
+func (r *certificateImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state certificateImportRsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_certificate_import", "create", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource create", map[string]any{
+		"terraform_provider_function": "Create",
+		"resource_name":               "sase_certificate_import",
+		"folder":                      state.Folder.ValueString(),
+		"name":                        state.Name.ValueString(),
+		"format":                      state.Format.ValueString(),
+		"has_key_file":                !state.KeyFile.IsNull(),
+	})
+
+	// Prepare to import the certificate.
+	svc := kmfIrpR.NewClient(r.client)
+	input := kmfIrpR.ImportInput{
+		Folder:          state.Folder.ValueString(),
+		Name:            state.Name.ValueString(),
+		Format:          state.Format.ValueString(),
+		CertificateFile: state.CertificateFile.ValueString(),
+		KeyFile:         state.KeyFile.ValueString(),
+		Passphrase:      state.Passphrase.ValueString(),
+	}
+
+	// Perform the operation.
+	ans, err := svc.Import(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(input.Folder)
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(ans.ObjectId)
+	state.Id = types.StringValue(idBuilder.String())
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.Algorithm = types.StringValue(ans.Algorithm)
+	state.Ca = types.BoolValue(ans.Ca)
+	state.CommonName = types.StringValue(ans.CommonName)
+	state.ExpiryEpoch = types.StringValue(ans.ExpiryEpoch)
+	state.Issuer = types.StringValue(ans.Issuer)
+	state.NotValidAfter = types.StringValue(ans.NotValidAfter)
+	state.NotValidBefore = types.StringValue(ans.NotValidBefore)
+	state.PublicKey = types.StringValue(ans.PublicKey)
+	state.Subject = types.StringValue(ans.Subject)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read resource.
+func (r *certificateImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The certificate material and the private key are not returned, so
+	// they are kept as they are in the state.
+	var state certificateImportRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokens := strings.Split(state.Id.ValueString(), IdSeparator)
+	if len(tokens) != 2 {
+		resp.Diagnostics.AddError("Error in resource ID format", "Expected 2 tokens")
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_certificate_import", "read", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource read", map[string]any{
+		"terraform_provider_function": "Read",
+		"resource_name":               "sase_certificate_import",
+		"locMap":                      map[string]int{"Folder": 0, "ObjectId": 1},
+		"tokens":                      tokens,
+	})
+
+	// Prepare to read the config.
+	svc := kmfIrpR.NewClient(r.client)
+	input := kmfIrpR.ReadInput{
+		ObjectId: tokens[1],
+	}
+
+	// Perform the operation.
+	ans, err := svc.Read(ctx, input)
+	if err != nil {
+		if IsObjectNotFound(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
+		}
+		return
+	}
+
+	// Store the answer to state.
+	state.Folder = types.StringValue(tokens[0])
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.Algorithm = types.StringValue(ans.Algorithm)
+	state.Ca = types.BoolValue(ans.Ca)
+	state.CommonName = types.StringValue(ans.CommonName)
+	state.ExpiryEpoch = types.StringValue(ans.ExpiryEpoch)
+	state.Issuer = types.StringValue(ans.Issuer)
+	state.NotValidAfter = types.StringValue(ans.NotValidAfter)
+	state.NotValidBefore = types.StringValue(ans.NotValidBefore)
+	state.PublicKey = types.StringValue(ans.PublicKey)
+	state.Subject = types.StringValue(ans.Subject)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update resource.
+func (r *certificateImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All inputs require replacement, so only the timeouts can change here.
+	var plan, state certificateImportRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Timeouts = plan.Timeouts
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete resource.
+func (r *certificateImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state certificateImportRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_certificate_import", "delete", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource delete", map[string]any{
+		"terraform_provider_function": "Delete",
+		"resource_name":               "sase_certificate_import",
+		"object_id":                   state.ObjectId.ValueString(),
+	})
+
+	svc := kmfIrpR.NewClient(r.client)
+	input := kmfIrpR.DeleteInput{
+		ObjectId: state.ObjectId.ValueString(),
+	}
+
+	// Perform the operation.
+	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
+	}
+}
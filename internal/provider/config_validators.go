@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// The API models its variants as a `oneOf` of objects, which the schema flattens
+// into a nested attribute with one optional attribute per variant.  Variants at
+// the root of the schema are checked with the resourcevalidator package, but
+// those validators report a missing configuration when the nested attribute
+// holding the variants is itself not configured, so nested variants are
+// checked with ExactlyOneOfNested and AtLeastOneOfNested instead.
+
+var _ resource.ConfigValidator = &nestedOneOfValidator{}
+
+// ExactlyOneOfNested returns a config validator that checks that exactly one
+// of the named attributes is configured in each object matching parent.
+// Objects that are null or unknown are not checked.
+func ExactlyOneOfNested(parent path.Expression, names ...string) resource.ConfigValidator {
+	return &nestedOneOfValidator{
+		parent: parent,
+		names:  names,
+	}
+}
+
+// AtLeastOneOfNested returns a config validator that checks that at least one
+// of the named attributes is configured in each object matching parent.
+// Objects that are null or unknown are not checked.
+func AtLeastOneOfNested(parent path.Expression, names ...string) resource.ConfigValidator {
+	return &nestedOneOfValidator{
+		parent:  parent,
+		names:   names,
+		atLeast: true,
+	}
+}
+
+type nestedOneOfValidator struct {
+	parent  path.Expression
+	names   []string
+	atLeast bool
+}
+
+func (v nestedOneOfValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v nestedOneOfValidator) MarkdownDescription(_ context.Context) string {
+	if v.atLeast {
+		return fmt.Sprintf("At least one of these attributes must be configured in %s: %v", v.parent, v.names)
+	}
+
+	return fmt.Sprintf("Exactly one of these attributes must be configured in %s: %v", v.parent, v.names)
+}
+
+func (v nestedOneOfValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	parents, diags := req.Config.PathMatches(ctx, v.parent)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	for _, parent := range parents {
+		// A null ancestor of the parent is matched as well, so this also
+		// skips anything below a null object or list.
+		var value attr.Value
+		diags = req.Config.GetAttribute(ctx, parent, &value)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		exprs := make(path.Expressions, 0, len(v.names))
+		for _, name := range v.names {
+			exprs = append(exprs, parent.AtName(name).Expression())
+		}
+
+		var cv resource.ConfigValidator
+		if v.atLeast {
+			cv = resourcevalidator.AtLeastOneOf(exprs...)
+		} else {
+			cv = resourcevalidator.ExactlyOneOf(exprs...)
+		}
+
+		var sub resource.ValidateConfigResponse
+		cv.ValidateResource(ctx, req, &sub)
+		resp.Diagnostics.Append(sub.Diagnostics...)
+	}
+}
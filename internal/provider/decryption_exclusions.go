@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/paloaltonetworks/sase-go"
+	"github.com/paloaltonetworks/sase-go/api"
 	jxvqaET "github.com/paloaltonetworks/sase-go/netsec/schema/decryption/exclusions"
 	zMcbmzn "github.com/paloaltonetworks/sase-go/netsec/service/v1/decryptionexclusions"
 
@@ -164,7 +165,8 @@ type decryptionExclusionsResource struct {
 }
 
 type decryptionExclusionsRsModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
 
 	// Input.
 	Folder types.String `tfsdk:"folder"`
@@ -204,6 +206,9 @@ func (r *decryptionExclusionsResource) Schema(_ context.Context, _ resource.Sche
 				Validators: []validator.String{
 					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 
 			"description": rsschema.StringAttribute{
@@ -229,6 +234,9 @@ func (r *decryptionExclusionsResource) Schema(_ context.Context, _ resource.Sche
 				Required:            true,
 			},
 		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_decryption_exclusions"),
+		},
 	}
 }
 
@@ -249,6 +257,9 @@ func (r *decryptionExclusionsResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_decryption_exclusions", "create", state.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource create", map[string]any{
 		"terraform_provider_function": "Create",
@@ -269,7 +280,7 @@ func (r *decryptionExclusionsResource) Create(ctx context.Context, req resource.
 	// Perform the operation.
 	ans, err := svc.Create(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in create", err.Error())
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
 		return
 	}
 
@@ -302,6 +313,13 @@ func (r *decryptionExclusionsResource) Read(ctx context.Context, req resource.Re
 	}
 
 	var state decryptionExclusionsRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_decryption_exclusions", "read", state.Timeouts)
+	defer cancel()
 
 	// Basic logging.
 	tflog.Info(ctx, "performing resource read", map[string]any{
@@ -324,7 +342,7 @@ func (r *decryptionExclusionsResource) Read(ctx context.Context, req resource.Re
 		if IsObjectNotFound(err) {
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error reading config", err.Error())
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
 		}
 		return
 	}
@@ -353,6 +371,9 @@ func (r *decryptionExclusionsResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_decryption_exclusions", "update", plan.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource update", map[string]any{
 		"terraform_provider_function": "Update",
@@ -373,11 +394,12 @@ func (r *decryptionExclusionsResource) Update(ctx context.Context, req resource.
 	// Perform the operation.
 	ans, err := svc.Update(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in update", err.Error())
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
 		return
 	}
 
 	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
 	state.Description = types.StringValue(ans.Description)
 	state.ObjectId = types.StringValue(ans.ObjectId)
 	state.Name = types.StringValue(ans.Name)
@@ -393,6 +415,16 @@ func (r *decryptionExclusionsResource) Delete(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_decryption_exclusions", "delete", timeouts)
+	defer cancel()
+
 	id := idType.ValueString()
 	tokens := strings.Split(id, IdSeparator)
 	if len(tokens) != 2 {
@@ -415,10 +447,59 @@ func (r *decryptionExclusionsResource) Delete(ctx context.Context, req resource.
 
 	// Perform the operation.
 	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
-		resp.Diagnostics.AddError("Error in delete", err.Error())
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
 	}
 }
 
+// ImportState imports by either the resource ID or by location params and name,
+// such as `folder=...,name=...`.
 func (r *decryptionExclusionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	params, ok, err := ParseImportId(req.ID, "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_decryption_exclusions",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := zMcbmzn.NewClient(r.client)
+	input := zMcbmzn.ListInput{
+		Folder: params["folder"],
+		Name:   api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
 }
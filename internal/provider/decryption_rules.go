@@ -10,6 +10,7 @@ import (
 	fKFKDxk "github.com/paloaltonetworks/sase-go/netsec/schema/decryption/rules"
 	vWYSjCE "github.com/paloaltonetworks/sase-go/netsec/service/v1/decryptionrules"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -43,6 +44,9 @@ type decryptionRulesListDsModel struct {
 	// Input.
 	Limit    types.Int64  `tfsdk:"limit"`
 	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
 	Position types.String `tfsdk:"position"`
 	Folder   types.String `tfsdk:"folder"`
 	Name     types.String `tfsdk:"name"`
@@ -114,6 +118,27 @@ func (d *decryptionRulesListDataSource) Schema(_ context.Context, _ datasource.S
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"position": dsschema.StringAttribute{
 				Description:         "The position of a security rule. Value must be one of: `\"pre\"`, `\"post\"`.",
 				MarkdownDescription: "The position of a security rule. Value must be one of: `\"pre\"`, `\"post\"`.",
@@ -333,12 +358,32 @@ func (d *decryptionRulesListDataSource) Read(ctx context.Context, req datasource
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -711,9 +756,10 @@ func (d *decryptionRulesDataSource) Read(ctx context.Context, req datasource.Rea
 
 // Resource.
 var (
-	_ resource.Resource                = &decryptionRulesResource{}
-	_ resource.ResourceWithConfigure   = &decryptionRulesResource{}
-	_ resource.ResourceWithImportState = &decryptionRulesResource{}
+	_ resource.Resource                     = &decryptionRulesResource{}
+	_ resource.ResourceWithConfigure        = &decryptionRulesResource{}
+	_ resource.ResourceWithConfigValidators = &decryptionRulesResource{}
+	_ resource.ResourceWithImportState      = &decryptionRulesResource{}
 )
 
 func NewDecryptionRulesResource() resource.Resource {
@@ -725,7 +771,8 @@ type decryptionRulesResource struct {
 }
 
 type decryptionRulesRsModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
 
 	// Input.
 	Position types.String `tfsdk:"position"`
@@ -790,6 +837,9 @@ func (r *decryptionRulesResource) Schema(_ context.Context, _ resource.SchemaReq
 				Validators: []validator.String{
 					stringvalidator.OneOf("pre", "post"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"folder": rsschema.StringAttribute{
 				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
@@ -798,6 +848,9 @@ func (r *decryptionRulesResource) Schema(_ context.Context, _ resource.SchemaReq
 				Validators: []validator.String{
 					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 
 			"action": rsschema.StringAttribute{
@@ -978,6 +1031,16 @@ func (r *decryptionRulesResource) Schema(_ context.Context, _ resource.SchemaReq
 				},
 			},
 		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_decryption_rules"),
+		},
+	}
+}
+
+// ConfigValidators returns the checks for mutually exclusive attributes.
+func (r *decryptionRulesResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		ExactlyOneOfNested(path.MatchRoot("type"), "ssl_forward_proxy", "ssl_inbound_inspection"),
 	}
 }
 
@@ -998,6 +1061,9 @@ func (r *decryptionRulesResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_decryption_rules", "create", state.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource create", map[string]any{
 		"terraform_provider_function": "Create",
@@ -1047,7 +1113,7 @@ func (r *decryptionRulesResource) Create(ctx context.Context, req resource.Creat
 	// Perform the operation.
 	ans, err := svc.Create(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in create", err.Error())
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
 		return
 	}
 
@@ -1109,6 +1175,13 @@ func (r *decryptionRulesResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	var state decryptionRulesRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_decryption_rules", "read", state.Timeouts)
+	defer cancel()
 
 	// Basic logging.
 	tflog.Info(ctx, "performing resource read", map[string]any{
@@ -1131,7 +1204,7 @@ func (r *decryptionRulesResource) Read(ctx context.Context, req resource.ReadReq
 		if IsObjectNotFound(err) {
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error reading config", err.Error())
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
 		}
 		return
 	}
@@ -1188,6 +1261,9 @@ func (r *decryptionRulesResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_decryption_rules", "update", plan.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource update", map[string]any{
 		"terraform_provider_function": "Update",
@@ -1235,11 +1311,12 @@ func (r *decryptionRulesResource) Update(ctx context.Context, req resource.Updat
 	// Perform the operation.
 	ans, err := svc.Update(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in update", err.Error())
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
 		return
 	}
 
 	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
 	var var2 *decryptionRulesRsModelTypeObject
 	if ans.Type != nil {
 		var2 = &decryptionRulesRsModelTypeObject{}
@@ -1282,6 +1359,16 @@ func (r *decryptionRulesResource) Delete(ctx context.Context, req resource.Delet
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_decryption_rules", "delete", timeouts)
+	defer cancel()
+
 	id := idType.ValueString()
 	tokens := strings.Split(id, IdSeparator)
 	if len(tokens) != 3 {
@@ -1304,10 +1391,62 @@ func (r *decryptionRulesResource) Delete(ctx context.Context, req resource.Delet
 
 	// Perform the operation.
 	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
-		resp.Diagnostics.AddError("Error in delete", err.Error())
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
 	}
 }
 
+// ImportState imports by either the resource ID or by location params and name,
+// such as `position=...,folder=...,name=...`.
 func (r *decryptionRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	params, ok, err := ParseImportId(req.ID, "position", "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_decryption_rules",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := vWYSjCE.NewClient(r.client)
+	input := vWYSjCE.ListInput{
+		Position: params["position"],
+		Folder:   params["folder"],
+		Name:     api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["position"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
 }
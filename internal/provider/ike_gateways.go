@@ -10,6 +10,7 @@ import (
 	zAHtTyI "github.com/paloaltonetworks/sase-go/netsec/schema/ike/gateways"
 	fGoRZph "github.com/paloaltonetworks/sase-go/netsec/service/v1/ikegateways"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -41,10 +42,13 @@ type ikeGatewaysListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Name   types.String `tfsdk:"name"`
-	Folder types.String `tfsdk:"folder"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Name     types.String `tfsdk:"name"`
+	Folder   types.String `tfsdk:"folder"`
 
 	// Output.
 	Data []ikeGatewaysListDsModelConfig `tfsdk:"data"`
@@ -161,6 +165,27 @@ func (d *ikeGatewaysListDataSource) Schema(_ context.Context, _ datasource.Schem
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"name": dsschema.StringAttribute{
 				Description:         "The name of the entry.",
 				MarkdownDescription: "The name of the entry.",
@@ -218,6 +243,7 @@ func (d *ikeGatewaysListDataSource) Schema(_ context.Context, _ datasource.Schem
 											Description:         "The `key` parameter.",
 											MarkdownDescription: "The `key` parameter.",
 											Computed:            true,
+											Sensitive:           true,
 										},
 									},
 								},
@@ -451,12 +477,32 @@ func (d *ikeGatewaysListDataSource) Read(ctx context.Context, req datasource.Rea
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -755,6 +801,7 @@ func (d *ikeGatewaysDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 								Description:         "The `key` parameter.",
 								MarkdownDescription: "The `key` parameter.",
 								Computed:            true,
+								Sensitive:           true,
 							},
 						},
 					},
@@ -1068,9 +1115,10 @@ func (d *ikeGatewaysDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 // Resource.
 var (
-	_ resource.Resource                = &ikeGatewaysResource{}
-	_ resource.ResourceWithConfigure   = &ikeGatewaysResource{}
-	_ resource.ResourceWithImportState = &ikeGatewaysResource{}
+	_ resource.Resource                     = &ikeGatewaysResource{}
+	_ resource.ResourceWithConfigure        = &ikeGatewaysResource{}
+	_ resource.ResourceWithConfigValidators = &ikeGatewaysResource{}
+	_ resource.ResourceWithImportState      = &ikeGatewaysResource{}
 )
 
 func NewIkeGatewaysResource() resource.Resource {
@@ -1082,11 +1130,16 @@ type ikeGatewaysResource struct {
 }
 
 type ikeGatewaysRsModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
 
 	// Input.
 	Folder types.String `tfsdk:"folder"`
 
+	// Secrets.
+	SecretHashes  map[string]types.String `tfsdk:"secret_hashes"`
+	SecretVersion types.String            `tfsdk:"secret_version"`
+
 	// Request body input.
 	// Ref: #/components/schemas/ike-gateways
 	Authentication ikeGatewaysRsModelAuthenticationObject  `tfsdk:"authentication"`
@@ -1166,6 +1219,16 @@ type ikeGatewaysRsModelNatTraversalObject struct {
 	Enable types.Bool `tfsdk:"enable"`
 }
 
+// secrets returns the write-only secrets in the model, keyed by location.
+func (o *ikeGatewaysRsModel) secrets() map[string]*types.String {
+	ans := make(map[string]*types.String)
+	if o.Authentication.PreSharedKey != nil {
+		ans["authentication.pre_shared_key.key"] = &o.Authentication.PreSharedKey.Key
+	}
+
+	return ans
+}
+
 // Metadata returns the data source type name.
 func (r *ikeGatewaysResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_ike_gateways"
@@ -1194,6 +1257,22 @@ func (r *ikeGatewaysResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Validators: []validator.String{
 					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Secrets.
+			"secret_hashes": rsschema.MapAttribute{
+				Description:         "Hashes of the encrypted secrets returned by the server, used to detect secrets changed outside of Terraform.",
+				MarkdownDescription: "Hashes of the encrypted secrets returned by the server, used to detect secrets changed outside of Terraform.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"secret_version": rsschema.StringAttribute{
+				Description:         "An arbitrary value that, when changed, forces all secrets to be sent to the server again.",
+				MarkdownDescription: "An arbitrary value that, when changed, forces all secrets to be sent to the server again.",
+				Optional:            true,
 			},
 
 			"authentication": rsschema.SingleNestedAttribute{
@@ -1245,6 +1324,7 @@ func (r *ikeGatewaysResource) Schema(_ context.Context, _ resource.SchemaRequest
 								MarkdownDescription: "The `key` parameter.",
 								Optional:            true,
 								Computed:            true,
+								Sensitive:           true,
 								PlanModifiers: []planmodifier.String{
 									DefaultString(""),
 								},
@@ -1326,25 +1406,29 @@ func (r *ikeGatewaysResource) Schema(_ context.Context, _ resource.SchemaRequest
 						Optional:            true,
 					},
 					"fqdn": rsschema.StringAttribute{
-						Description:         "The `fqdn` parameter. String length must be at most 255.",
-						MarkdownDescription: "The `fqdn` parameter. String length must be at most 255.",
+						Description:         "The `fqdn` parameter. String length must be at most 255. Value must be a fully qualified domain name.",
+						MarkdownDescription: "The `fqdn` parameter. String length must be at most 255. Value must be a fully qualified domain name.",
 						Optional:            true,
 						Computed:            true,
 						PlanModifiers: []planmodifier.String{
 							DefaultString(""),
 						},
 						Validators: []validator.String{
+							IsFqdn(),
 							stringvalidator.LengthAtMost(255),
 						},
 					},
 					"ip": rsschema.StringAttribute{
-						Description:         "The `ip` parameter.",
-						MarkdownDescription: "The `ip` parameter.",
+						Description:         "The `ip` parameter. Value must be an IPv4 or IPv6 address.",
+						MarkdownDescription: "The `ip` parameter. Value must be an IPv4 or IPv6 address.",
 						Optional:            true,
 						Computed:            true,
 						PlanModifiers: []planmodifier.String{
 							DefaultString(""),
 						},
+						Validators: []validator.String{
+							IsIpAddress(),
+						},
 					},
 				},
 			},
@@ -1511,6 +1595,18 @@ func (r *ikeGatewaysResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_ike_gateways"),
+		},
+	}
+}
+
+// ConfigValidators returns the checks for mutually exclusive attributes.
+func (r *ikeGatewaysResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		ExactlyOneOfNested(path.MatchRoot("authentication"), "local_certificate", "pre_shared_key"),
+		ExactlyOneOfNested(path.MatchRoot("peer_address"), "dynamic_value", "fqdn", "ip"),
+		AtLeastOneOfNested(path.MatchRoot("protocol"), "ikev1", "ikev2"),
 	}
 }
 
@@ -1531,6 +1627,9 @@ func (r *ikeGatewaysResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_ike_gateways", "create", state.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource create", map[string]any{
 		"terraform_provider_function": "Create",
@@ -1634,10 +1733,13 @@ func (r *ikeGatewaysResource) Create(ctx context.Context, req resource.CreateReq
 	// Perform the operation.
 	ans, err := svc.Create(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in create", err.Error())
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
 		return
 	}
 
+	// Save the plaintext secrets before they are overwritten.
+	plan := state
+
 	// Store the answer to state.
 	var idBuilder strings.Builder
 	idBuilder.WriteString(input.Folder)
@@ -1731,6 +1833,9 @@ func (r *ikeGatewaysResource) Create(ctx context.Context, req resource.CreateReq
 	state.Protocol = var21
 	state.ProtocolCommon = var26
 
+	// Keep the plaintext secrets instead of what the server returned.
+	state.SecretHashes = SaveSecrets(state.secrets(), plan.secrets())
+
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -1750,6 +1855,13 @@ func (r *ikeGatewaysResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	var state ikeGatewaysRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_ike_gateways", "read", state.Timeouts)
+	defer cancel()
 
 	// Basic logging.
 	tflog.Info(ctx, "performing resource read", map[string]any{
@@ -1772,7 +1884,7 @@ func (r *ikeGatewaysResource) Read(ctx context.Context, req resource.ReadRequest
 		if IsObjectNotFound(err) {
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error reading config", err.Error())
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
 		}
 		return
 	}
@@ -1867,6 +1979,15 @@ func (r *ikeGatewaysResource) Read(ctx context.Context, req resource.ReadRequest
 	state.Protocol = var6
 	state.ProtocolCommon = var11
 
+	// Keep the plaintext secrets if they are unchanged on the server.
+	var prior ikeGatewaysRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.SecretHashes = RefreshSecrets(state.secrets(), prior.secrets(), prior.SecretHashes)
+	state.SecretVersion = prior.SecretVersion
+
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -1884,6 +2005,9 @@ func (r *ikeGatewaysResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_ike_gateways", "update", plan.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource update", map[string]any{
 		"terraform_provider_function": "Update",
@@ -1987,11 +2111,12 @@ func (r *ikeGatewaysResource) Update(ctx context.Context, req resource.UpdateReq
 	// Perform the operation.
 	ans, err := svc.Update(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in update", err.Error())
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
 		return
 	}
 
 	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
 	var var15 ikeGatewaysRsModelAuthenticationObject
 	var var16 *ikeGatewaysRsModelLocalCertificateObject
 	if ans.Authentication.LocalCertificate != nil {
@@ -2079,6 +2204,10 @@ func (r *ikeGatewaysResource) Update(ctx context.Context, req resource.UpdateReq
 	state.Protocol = var21
 	state.ProtocolCommon = var26
 
+	// Keep the plaintext secrets instead of what the server returned.
+	state.SecretHashes = SaveSecrets(state.secrets(), plan.secrets())
+	state.SecretVersion = plan.SecretVersion
+
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -2090,6 +2219,16 @@ func (r *ikeGatewaysResource) Delete(ctx context.Context, req resource.DeleteReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_ike_gateways", "delete", timeouts)
+	defer cancel()
+
 	id := idType.ValueString()
 	tokens := strings.Split(id, IdSeparator)
 	if len(tokens) != 2 {
@@ -2112,10 +2251,59 @@ func (r *ikeGatewaysResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	// Perform the operation.
 	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
-		resp.Diagnostics.AddError("Error in delete", err.Error())
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
 	}
 }
 
+// ImportState imports by either the resource ID or by location params and name,
+// such as `folder=...,name=...`.
 func (r *ikeGatewaysResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	params, ok, err := ParseImportId(req.ID, "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_ike_gateways",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := fGoRZph.NewClient(r.client)
+	input := fGoRZph.ListInput{
+		Folder: params["folder"],
+		Name:   api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
 }
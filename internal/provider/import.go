@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ParseImportId parses an import ID made up of comma separated `key=value`
+// pairs, such as `folder=Shared,name=allow-dns`.  All of the given keys must
+// be present.
+//
+// If the import ID is not in this format, then false is returned, and the ID
+// should be treated as the canonical resource ID instead.
+func ParseImportId(id string, keys ...string) (map[string]string, bool, error) {
+	if !strings.Contains(id, "=") {
+		return nil, false, nil
+	}
+
+	ans := make(map[string]string, len(keys))
+	for _, pair := range strings.Split(id, ",") {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, true, fmt.Errorf("expected %q to be in the form key=value", pair)
+		}
+		key = strings.TrimSpace(key)
+
+		known := false
+		for _, x := range keys {
+			if x == key {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return nil, true, fmt.Errorf("unknown key %q, expected: %s", key, strings.Join(keys, ", "))
+		}
+		if _, ok = ans[key]; ok {
+			return nil, true, fmt.Errorf("key %q given more than once", key)
+		}
+
+		ans[key] = value
+	}
+
+	for _, key := range keys {
+		if ans[key] == "" {
+			return nil, true, fmt.Errorf("missing required key %q, expected: %s", key, strings.Join(keys, ", "))
+		}
+	}
+
+	return ans, true, nil
+}
+
+// ImportObjectId returns the object ID to import, given the object IDs that
+// matched the import params.  An error is returned unless there is exactly one
+// match.
+func ImportObjectId(params map[string]string, ids []string) (string, error) {
+	switch len(ids) {
+	case 1:
+		return ids[0], nil
+	case 0:
+		return "", fmt.Errorf("no object matches %s", formatImportParams(params))
+	}
+
+	return "", fmt.Errorf("%d objects match %s (object IDs: %s), import by ID instead", len(ids), formatImportParams(params), strings.Join(ids, ", "))
+}
+
+func formatImportParams(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	list := make([]string, 0, len(keys))
+	for _, key := range keys {
+		list = append(list, fmt.Sprintf("%s=%q", key, params[key]))
+	}
+
+	return strings.Join(list, ", ")
+}
@@ -42,10 +42,13 @@ type ipsecTunnelsListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Name   types.String `tfsdk:"name"`
-	Folder types.String `tfsdk:"folder"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Name     types.String `tfsdk:"name"`
+	Folder   types.String `tfsdk:"folder"`
 
 	// Output.
 	Data []ipsecTunnelsListDsModelConfig `tfsdk:"data"`
@@ -133,6 +136,27 @@ func (d *ipsecTunnelsListDataSource) Schema(_ context.Context, _ datasource.Sche
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"name": dsschema.StringAttribute{
 				Description:         "The name of the entry.",
 				MarkdownDescription: "The name of the entry.",
@@ -353,12 +377,32 @@ func (d *ipsecTunnelsListDataSource) Read(ctx context.Context, req datasource.Re
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -819,9 +863,10 @@ func (d *ipsecTunnelsDataSource) Read(ctx context.Context, req datasource.ReadRe
 
 // Resource.
 var (
-	_ resource.Resource                = &ipsecTunnelsResource{}
-	_ resource.ResourceWithConfigure   = &ipsecTunnelsResource{}
-	_ resource.ResourceWithImportState = &ipsecTunnelsResource{}
+	_ resource.Resource                     = &ipsecTunnelsResource{}
+	_ resource.ResourceWithConfigure        = &ipsecTunnelsResource{}
+	_ resource.ResourceWithConfigValidators = &ipsecTunnelsResource{}
+	_ resource.ResourceWithImportState      = &ipsecTunnelsResource{}
 )
 
 func NewIpsecTunnelsResource() resource.Resource {
@@ -833,7 +878,8 @@ type ipsecTunnelsResource struct {
 }
 
 type ipsecTunnelsRsModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
 
 	// Input.
 	Folder types.String `tfsdk:"folder"`
@@ -916,6 +962,9 @@ func (r *ipsecTunnelsResource) Schema(_ context.Context, _ resource.SchemaReques
 				Validators: []validator.String{
 					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 
 			"anti_replay": rsschema.BoolAttribute{
@@ -1110,9 +1159,12 @@ func (r *ipsecTunnelsResource) Schema(_ context.Context, _ resource.SchemaReques
 				Optional:            true,
 				Attributes: map[string]rsschema.Attribute{
 					"destination_ip": rsschema.StringAttribute{
-						Description:         "The `destination_ip` parameter.",
-						MarkdownDescription: "The `destination_ip` parameter.",
+						Description:         "The `destination_ip` parameter. Value must be an IPv4 or IPv6 address.",
+						MarkdownDescription: "The `destination_ip` parameter. Value must be an IPv4 or IPv6 address.",
 						Required:            true,
+						Validators: []validator.String{
+							IsIpAddress(),
+						},
 					},
 					"enable": rsschema.BoolAttribute{
 						Description:         "The `enable` parameter. Default: `true`.",
@@ -1135,6 +1187,21 @@ func (r *ipsecTunnelsResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_ipsec_tunnels"),
+		},
+	}
+}
+
+// ConfigValidators returns the checks for mutually exclusive attributes.
+func (r *ipsecTunnelsResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		ExactlyOneOfNested(
+			path.MatchRoot("auto_key").AtName("proxy_id").AtAnyListIndex().AtName("protocol"),
+			"number",
+			"tcp",
+			"udp",
+		),
 	}
 }
 
@@ -1155,6 +1222,9 @@ func (r *ipsecTunnelsResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_ipsec_tunnels", "create", state.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource create", map[string]any{
 		"terraform_provider_function": "Create",
@@ -1232,7 +1302,7 @@ func (r *ipsecTunnelsResource) Create(ctx context.Context, req resource.CreateRe
 	// Perform the operation.
 	ans, err := svc.Create(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in create", err.Error())
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
 		return
 	}
 
@@ -1322,6 +1392,13 @@ func (r *ipsecTunnelsResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	var state ipsecTunnelsRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_ipsec_tunnels", "read", state.Timeouts)
+	defer cancel()
 
 	// Basic logging.
 	tflog.Info(ctx, "performing resource read", map[string]any{
@@ -1344,7 +1421,7 @@ func (r *ipsecTunnelsResource) Read(ctx context.Context, req resource.ReadReques
 		if IsObjectNotFound(err) {
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error reading config", err.Error())
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
 		}
 		return
 	}
@@ -1430,6 +1507,9 @@ func (r *ipsecTunnelsResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_ipsec_tunnels", "update", plan.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource update", map[string]any{
 		"terraform_provider_function": "Update",
@@ -1507,11 +1587,12 @@ func (r *ipsecTunnelsResource) Update(ctx context.Context, req resource.UpdateRe
 	// Perform the operation.
 	ans, err := svc.Update(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in update", err.Error())
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
 		return
 	}
 
 	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
 	var var12 ipsecTunnelsRsModelAutoKeyObject
 	var var13 []ipsecTunnelsRsModelIkeGatewayObject
 	if len(ans.AutoKey.IkeGateway) != 0 {
@@ -1584,6 +1665,16 @@ func (r *ipsecTunnelsResource) Delete(ctx context.Context, req resource.DeleteRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_ipsec_tunnels", "delete", timeouts)
+	defer cancel()
+
 	id := idType.ValueString()
 	tokens := strings.Split(id, IdSeparator)
 	if len(tokens) != 2 {
@@ -1606,10 +1697,59 @@ func (r *ipsecTunnelsResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	// Perform the operation.
 	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
-		resp.Diagnostics.AddError("Error in delete", err.Error())
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
 	}
 }
 
+// ImportState imports by either the resource ID or by location params and name,
+// such as `folder=...,name=...`.
 func (r *ipsecTunnelsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	params, ok, err := ParseImportId(req.ID, "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_ipsec_tunnels",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := fVAkWHS.NewClient(r.client)
+	input := fVAkWHS.ListInput{
+		Folder: params["folder"],
+		Name:   api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
 }
@@ -42,10 +42,13 @@ type ldapServerProfilesListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Folder types.String `tfsdk:"folder"`
-	Name   types.String `tfsdk:"name"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Folder   types.String `tfsdk:"folder"`
+	Name     types.String `tfsdk:"name"`
 
 	// Output.
 	Data []ldapServerProfilesListDsModelConfig `tfsdk:"data"`
@@ -104,6 +107,27 @@ func (d *ldapServerProfilesListDataSource) Schema(_ context.Context, _ datasourc
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"folder": dsschema.StringAttribute{
 				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
 				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
@@ -139,6 +163,7 @@ func (d *ldapServerProfilesListDataSource) Schema(_ context.Context, _ datasourc
 							Description:         "The `bind_password` parameter.",
 							MarkdownDescription: "The `bind_password` parameter.",
 							Computed:            true,
+							Sensitive:           true,
 						},
 						"bind_timelimit": dsschema.StringAttribute{
 							Description:         "The `bind_timelimit` parameter.",
@@ -255,12 +280,32 @@ func (d *ldapServerProfilesListDataSource) Read(ctx context.Context, req datasou
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -416,6 +461,7 @@ func (d *ldapServerProfilesDataSource) Schema(_ context.Context, _ datasource.Sc
 				Description:         "The `bind_password` parameter.",
 				MarkdownDescription: "The `bind_password` parameter.",
 				Computed:            true,
+				Sensitive:           true,
 			},
 			"bind_timelimit": dsschema.StringAttribute{
 				Description:         "The `bind_timelimit` parameter.",
@@ -563,7 +609,8 @@ type ldapServerProfilesResource struct {
 }
 
 type ldapServerProfilesRsModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
 
 	// Input.
 	Folder types.String `tfsdk:"folder"`
@@ -617,6 +664,9 @@ func (r *ldapServerProfilesResource) Schema(_ context.Context, _ resource.Schema
 				Validators: []validator.String{
 					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 
 			"base": rsschema.StringAttribute{
@@ -648,6 +698,7 @@ func (r *ldapServerProfilesResource) Schema(_ context.Context, _ resource.Schema
 				MarkdownDescription: "The `bind_password` parameter. String length must be at most 121.",
 				Optional:            true,
 				Computed:            true,
+				Sensitive:           true,
 				PlanModifiers: []planmodifier.String{
 					DefaultString(""),
 				},
@@ -700,13 +751,16 @@ func (r *ldapServerProfilesResource) Schema(_ context.Context, _ resource.Schema
 				NestedObject: rsschema.NestedAttributeObject{
 					Attributes: map[string]rsschema.Attribute{
 						"address": rsschema.StringAttribute{
-							Description:         "The `address` parameter.",
-							MarkdownDescription: "The `address` parameter.",
+							Description:         "The `address` parameter. Value must be an IPv4 or IPv6 address or a fully qualified domain name.",
+							MarkdownDescription: "The `address` parameter. Value must be an IPv4 or IPv6 address or a fully qualified domain name.",
 							Optional:            true,
 							Computed:            true,
 							PlanModifiers: []planmodifier.String{
 								DefaultString(""),
 							},
+							Validators: []validator.String{
+								IsIpAddressOrFqdn(),
+							},
 						},
 						"name": rsschema.StringAttribute{
 							Description:         "The `name` parameter.",
@@ -760,6 +814,9 @@ func (r *ldapServerProfilesResource) Schema(_ context.Context, _ resource.Schema
 				},
 			},
 		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_ldap_server_profiles"),
+		},
 	}
 }
 
@@ -780,6 +837,9 @@ func (r *ldapServerProfilesResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_ldap_server_profiles", "create", state.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource create", map[string]any{
 		"terraform_provider_function": "Create",
@@ -820,7 +880,7 @@ func (r *ldapServerProfilesResource) Create(ctx context.Context, req resource.Cr
 	// Perform the operation.
 	ans, err := svc.Create(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in create", err.Error())
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
 		return
 	}
 
@@ -873,6 +933,13 @@ func (r *ldapServerProfilesResource) Read(ctx context.Context, req resource.Read
 	}
 
 	var state ldapServerProfilesRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_ldap_server_profiles", "read", state.Timeouts)
+	defer cancel()
 
 	// Basic logging.
 	tflog.Info(ctx, "performing resource read", map[string]any{
@@ -895,7 +962,7 @@ func (r *ldapServerProfilesResource) Read(ctx context.Context, req resource.Read
 		if IsObjectNotFound(err) {
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error reading config", err.Error())
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
 		}
 		return
 	}
@@ -944,6 +1011,9 @@ func (r *ldapServerProfilesResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_ldap_server_profiles", "update", plan.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource update", map[string]any{
 		"terraform_provider_function": "Update",
@@ -984,11 +1054,12 @@ func (r *ldapServerProfilesResource) Update(ctx context.Context, req resource.Up
 	// Perform the operation.
 	ans, err := svc.Update(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in update", err.Error())
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
 		return
 	}
 
 	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
 	var var4 []ldapServerProfilesRsModelServerObject
 	if len(ans.Server) != 0 {
 		var4 = make([]ldapServerProfilesRsModelServerObject, 0, len(ans.Server))
@@ -1024,6 +1095,16 @@ func (r *ldapServerProfilesResource) Delete(ctx context.Context, req resource.De
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_ldap_server_profiles", "delete", timeouts)
+	defer cancel()
+
 	id := idType.ValueString()
 	tokens := strings.Split(id, IdSeparator)
 	if len(tokens) != 2 {
@@ -1046,10 +1127,59 @@ func (r *ldapServerProfilesResource) Delete(ctx context.Context, req resource.De
 
 	// Perform the operation.
 	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
-		resp.Diagnostics.AddError("Error in delete", err.Error())
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
 	}
 }
 
+// ImportState imports by either the resource ID or by location params and name,
+// such as `folder=...,name=...`.
 func (r *ldapServerProfilesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	params, ok, err := ParseImportId(req.ID, "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_ldap_server_profiles",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := iMdQZcj.NewClient(r.client)
+	input := iMdQZcj.ListInput{
+		Folder: params["folder"],
+		Name:   api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
 }
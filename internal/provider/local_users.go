@@ -10,6 +10,7 @@ import (
 	jOMmLLT "github.com/paloaltonetworks/sase-go/netsec/schema/local/users"
 	lPYoCFo "github.com/paloaltonetworks/sase-go/netsec/service/v1/localusers"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -41,10 +42,13 @@ type localUsersListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Folder types.String `tfsdk:"folder"`
-	Name   types.String `tfsdk:"name"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Folder   types.String `tfsdk:"folder"`
+	Name     types.String `tfsdk:"name"`
 
 	// Output.
 	Data []localUsersListDsModelConfig `tfsdk:"data"`
@@ -89,6 +93,27 @@ func (d *localUsersListDataSource) Schema(_ context.Context, _ datasource.Schema
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"folder": dsschema.StringAttribute{
 				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
 				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
@@ -124,6 +149,7 @@ func (d *localUsersListDataSource) Schema(_ context.Context, _ datasource.Schema
 							Description:         "The `password` parameter.",
 							MarkdownDescription: "The `password` parameter.",
 							Computed:            true,
+							Sensitive:           true,
 						},
 					},
 				},
@@ -181,12 +207,32 @@ func (d *localUsersListDataSource) Read(ctx context.Context, req datasource.Read
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -303,6 +349,7 @@ func (d *localUsersDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				Description:         "The `password` parameter.",
 				MarkdownDescription: "The `password` parameter.",
 				Computed:            true,
+				Sensitive:           true,
 			},
 		},
 	}
@@ -376,11 +423,16 @@ type localUsersResource struct {
 }
 
 type localUsersRsModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
 
 	// Input.
 	Folder types.String `tfsdk:"folder"`
 
+	// Secrets.
+	SecretHashes  map[string]types.String `tfsdk:"secret_hashes"`
+	SecretVersion types.String            `tfsdk:"secret_version"`
+
 	// Request body input.
 	// Ref: #/components/schemas/local-users
 	ObjectId types.String `tfsdk:"object_id"`
@@ -388,6 +440,13 @@ type localUsersRsModel struct {
 	Password types.String `tfsdk:"password"`
 }
 
+// secrets returns the write-only secrets in the model, keyed by location.
+func (o *localUsersRsModel) secrets() map[string]*types.String {
+	return map[string]*types.String{
+		"password": &o.Password,
+	}
+}
+
 // Metadata returns the data source type name.
 func (r *localUsersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_local_users"
@@ -416,6 +475,22 @@ func (r *localUsersResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Validators: []validator.String{
 					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Secrets.
+			"secret_hashes": rsschema.MapAttribute{
+				Description:         "Hashes of the encrypted secrets returned by the server, used to detect secrets changed outside of Terraform.",
+				MarkdownDescription: "Hashes of the encrypted secrets returned by the server, used to detect secrets changed outside of Terraform.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"secret_version": rsschema.StringAttribute{
+				Description:         "An arbitrary value that, when changed, forces all secrets to be sent to the server again.",
+				MarkdownDescription: "An arbitrary value that, when changed, forces all secrets to be sent to the server again.",
+				Optional:            true,
 			},
 
 			"object_id": rsschema.StringAttribute{
@@ -438,11 +513,15 @@ func (r *localUsersResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description:         "The `password` parameter. String length must be at most 63.",
 				MarkdownDescription: "The `password` parameter. String length must be at most 63.",
 				Required:            true,
+				Sensitive:           true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtMost(63),
 				},
 			},
 		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_local_users"),
+		},
 	}
 }
 
@@ -463,6 +542,9 @@ func (r *localUsersResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_local_users", "create", state.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource create", map[string]any{
 		"terraform_provider_function": "Create",
@@ -483,10 +565,13 @@ func (r *localUsersResource) Create(ctx context.Context, req resource.CreateRequ
 	// Perform the operation.
 	ans, err := svc.Create(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in create", err.Error())
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
 		return
 	}
 
+	// Save the plaintext secrets before they are overwritten.
+	plan := state
+
 	// Store the answer to state.
 	var idBuilder strings.Builder
 	idBuilder.WriteString(input.Folder)
@@ -497,6 +582,9 @@ func (r *localUsersResource) Create(ctx context.Context, req resource.CreateRequ
 	state.Name = types.StringValue(ans.Name)
 	state.Password = types.StringValue(ans.Password)
 
+	// Keep the plaintext secrets instead of what the server returned.
+	state.SecretHashes = SaveSecrets(state.secrets(), plan.secrets())
+
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -516,6 +604,13 @@ func (r *localUsersResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	var state localUsersRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_local_users", "read", state.Timeouts)
+	defer cancel()
 
 	// Basic logging.
 	tflog.Info(ctx, "performing resource read", map[string]any{
@@ -538,7 +633,7 @@ func (r *localUsersResource) Read(ctx context.Context, req resource.ReadRequest,
 		if IsObjectNotFound(err) {
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error reading config", err.Error())
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
 		}
 		return
 	}
@@ -550,6 +645,15 @@ func (r *localUsersResource) Read(ctx context.Context, req resource.ReadRequest,
 	state.Name = types.StringValue(ans.Name)
 	state.Password = types.StringValue(ans.Password)
 
+	// Keep the plaintext secrets if they are unchanged on the server.
+	var prior localUsersRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.SecretHashes = RefreshSecrets(state.secrets(), prior.secrets(), prior.SecretHashes)
+	state.SecretVersion = prior.SecretVersion
+
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -567,6 +671,9 @@ func (r *localUsersResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_local_users", "update", plan.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource update", map[string]any{
 		"terraform_provider_function": "Update",
@@ -587,15 +694,20 @@ func (r *localUsersResource) Update(ctx context.Context, req resource.UpdateRequ
 	// Perform the operation.
 	ans, err := svc.Update(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in update", err.Error())
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
 		return
 	}
 
 	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
 	state.ObjectId = types.StringValue(ans.ObjectId)
 	state.Name = types.StringValue(ans.Name)
 	state.Password = types.StringValue(ans.Password)
 
+	// Keep the plaintext secrets instead of what the server returned.
+	state.SecretHashes = SaveSecrets(state.secrets(), plan.secrets())
+	state.SecretVersion = plan.SecretVersion
+
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -607,6 +719,16 @@ func (r *localUsersResource) Delete(ctx context.Context, req resource.DeleteRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_local_users", "delete", timeouts)
+	defer cancel()
+
 	id := idType.ValueString()
 	tokens := strings.Split(id, IdSeparator)
 	if len(tokens) != 2 {
@@ -629,10 +751,59 @@ func (r *localUsersResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	// Perform the operation.
 	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
-		resp.Diagnostics.AddError("Error in delete", err.Error())
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
 	}
 }
 
+// ImportState imports by either the resource ID or by location params and name,
+// such as `folder=...,name=...`.
 func (r *localUsersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	params, ok, err := ParseImportId(req.ID, "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_local_users",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := lPYoCFo.NewClient(r.client)
+	input := lPYoCFo.ListInput{
+		Folder: params["folder"],
+		Name:   api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
 }
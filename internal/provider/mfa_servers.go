@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/paloaltonetworks/sase-go"
+	"github.com/paloaltonetworks/sase-go/api"
 	deRyMEf "github.com/paloaltonetworks/sase-go/netsec/schema/mfa/servers"
 	wArkOsV "github.com/paloaltonetworks/sase-go/netsec/service/v1/mfaservers"
 
@@ -153,11 +154,13 @@ func (d *mfaServersDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 								Description:         "The `duo_integration_key` parameter.",
 								MarkdownDescription: "The `duo_integration_key` parameter.",
 								Computed:            true,
+								Sensitive:           true,
 							},
 							"duo_secret_key": dsschema.StringAttribute{
 								Description:         "The `duo_secret_key` parameter.",
 								MarkdownDescription: "The `duo_secret_key` parameter.",
 								Computed:            true,
+								Sensitive:           true,
 							},
 							"duo_timeout": dsschema.StringAttribute{
 								Description:         "The `duo_timeout` parameter.",
@@ -195,6 +198,7 @@ func (d *mfaServersDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 								Description:         "The `okta_token` parameter.",
 								MarkdownDescription: "The `okta_token` parameter.",
 								Computed:            true,
+								Sensitive:           true,
 							},
 						},
 					},
@@ -232,6 +236,7 @@ func (d *mfaServersDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 								Description:         "The `ping_token` parameter.",
 								MarkdownDescription: "The `ping_token` parameter.",
 								Computed:            true,
+								Sensitive:           true,
 							},
 						},
 					},
@@ -249,6 +254,7 @@ func (d *mfaServersDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 								Description:         "The `rsa_accesskey` parameter.",
 								MarkdownDescription: "The `rsa_accesskey` parameter.",
 								Computed:            true,
+								Sensitive:           true,
 							},
 							"rsa_api_host": dsschema.StringAttribute{
 								Description:         "The `rsa_api_host` parameter.",
@@ -384,9 +390,10 @@ func (d *mfaServersDataSource) Read(ctx context.Context, req datasource.ReadRequ
 
 // Resource.
 var (
-	_ resource.Resource                = &mfaServersResource{}
-	_ resource.ResourceWithConfigure   = &mfaServersResource{}
-	_ resource.ResourceWithImportState = &mfaServersResource{}
+	_ resource.Resource                     = &mfaServersResource{}
+	_ resource.ResourceWithConfigure        = &mfaServersResource{}
+	_ resource.ResourceWithConfigValidators = &mfaServersResource{}
+	_ resource.ResourceWithImportState      = &mfaServersResource{}
 )
 
 func NewMfaServersResource() resource.Resource {
@@ -398,12 +405,17 @@ type mfaServersResource struct {
 }
 
 type mfaServersRsModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
 
 	// Input.
 	Position types.String `tfsdk:"position"`
 	Folder   types.String `tfsdk:"folder"`
 
+	// Secrets.
+	SecretHashes  map[string]types.String `tfsdk:"secret_hashes"`
+	SecretVersion types.String            `tfsdk:"secret_version"`
+
 	// Request body input.
 	// Ref: #/components/schemas/mfa-servers
 	ObjectId       types.String                          `tfsdk:"object_id"`
@@ -453,6 +465,30 @@ type mfaServersRsModelRsaSecuridAccessV1Object struct {
 	RsaTimeout           types.String `tfsdk:"rsa_timeout"`
 }
 
+// secrets returns the write-only secrets in the model, keyed by location.
+func (o *mfaServersRsModel) secrets() map[string]*types.String {
+	ans := make(map[string]*types.String)
+	if o.MfaVendorType == nil {
+		return ans
+	}
+
+	if x := o.MfaVendorType.DuoSecurityV2; x != nil {
+		ans["mfa_vendor_type.duo_security_v2.duo_integration_key"] = &x.DuoIntegrationKey
+		ans["mfa_vendor_type.duo_security_v2.duo_secret_key"] = &x.DuoSecretKey
+	}
+	if x := o.MfaVendorType.OktaAdaptiveV1; x != nil {
+		ans["mfa_vendor_type.okta_adaptive_v1.okta_token"] = &x.OktaToken
+	}
+	if x := o.MfaVendorType.PingIdentityV1; x != nil {
+		ans["mfa_vendor_type.ping_identity_v1.ping_token"] = &x.PingToken
+	}
+	if x := o.MfaVendorType.RsaSecuridAccessV1; x != nil {
+		ans["mfa_vendor_type.rsa_securid_access_v1.rsa_accesskey"] = &x.RsaAccesskey
+	}
+
+	return ans
+}
+
 // Metadata returns the data source type name.
 func (r *mfaServersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_mfa_servers"
@@ -481,6 +517,9 @@ func (r *mfaServersResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Validators: []validator.String{
 					stringvalidator.OneOf("pre", "post"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"folder": rsschema.StringAttribute{
 				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
@@ -489,6 +528,22 @@ func (r *mfaServersResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Validators: []validator.String{
 					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Secrets.
+			"secret_hashes": rsschema.MapAttribute{
+				Description:         "Hashes of the encrypted secrets returned by the server, used to detect secrets changed outside of Terraform.",
+				MarkdownDescription: "Hashes of the encrypted secrets returned by the server, used to detect secrets changed outside of Terraform.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"secret_version": rsschema.StringAttribute{
+				Description:         "An arbitrary value that, when changed, forces all secrets to be sent to the server again.",
+				MarkdownDescription: "An arbitrary value that, when changed, forces all secrets to be sent to the server again.",
+				Optional:            true,
 			},
 
 			"object_id": rsschema.StringAttribute{
@@ -537,6 +592,7 @@ func (r *mfaServersResource) Schema(_ context.Context, _ resource.SchemaRequest,
 								MarkdownDescription: "The `duo_integration_key` parameter.",
 								Optional:            true,
 								Computed:            true,
+								Sensitive:           true,
 								PlanModifiers: []planmodifier.String{
 									DefaultString(""),
 								},
@@ -546,6 +602,7 @@ func (r *mfaServersResource) Schema(_ context.Context, _ resource.SchemaRequest,
 								MarkdownDescription: "The `duo_secret_key` parameter.",
 								Optional:            true,
 								Computed:            true,
+								Sensitive:           true,
 								PlanModifiers: []planmodifier.String{
 									DefaultString(""),
 								},
@@ -607,6 +664,7 @@ func (r *mfaServersResource) Schema(_ context.Context, _ resource.SchemaRequest,
 								MarkdownDescription: "The `okta_token` parameter.",
 								Optional:            true,
 								Computed:            true,
+								Sensitive:           true,
 								PlanModifiers: []planmodifier.String{
 									DefaultString(""),
 								},
@@ -668,6 +726,7 @@ func (r *mfaServersResource) Schema(_ context.Context, _ resource.SchemaRequest,
 								MarkdownDescription: "The `ping_token` parameter.",
 								Optional:            true,
 								Computed:            true,
+								Sensitive:           true,
 								PlanModifiers: []planmodifier.String{
 									DefaultString(""),
 								},
@@ -693,6 +752,7 @@ func (r *mfaServersResource) Schema(_ context.Context, _ resource.SchemaRequest,
 								MarkdownDescription: "The `rsa_accesskey` parameter.",
 								Optional:            true,
 								Computed:            true,
+								Sensitive:           true,
 								PlanModifiers: []planmodifier.String{
 									DefaultString(""),
 								},
@@ -743,6 +803,22 @@ func (r *mfaServersResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Required:            true,
 			},
 		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_mfa_servers"),
+		},
+	}
+}
+
+// ConfigValidators returns the checks for mutually exclusive attributes.
+func (r *mfaServersResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		ExactlyOneOfNested(
+			path.MatchRoot("mfa_vendor_type"),
+			"duo_security_v2",
+			"okta_adaptive_v1",
+			"ping_identity_v1",
+			"rsa_securid_access_v1",
+		),
 	}
 }
 
@@ -763,6 +839,9 @@ func (r *mfaServersResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_mfa_servers", "create", state.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource create", map[string]any{
 		"terraform_provider_function": "Create",
@@ -832,10 +911,13 @@ func (r *mfaServersResource) Create(ctx context.Context, req resource.CreateRequ
 	// Perform the operation.
 	ans, err := svc.Create(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in create", err.Error())
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
 		return
 	}
 
+	// Save the plaintext secrets before they are overwritten.
+	plan := state
+
 	// Store the answer to state.
 	var idBuilder strings.Builder
 	idBuilder.WriteString(input.Position)
@@ -895,6 +977,9 @@ func (r *mfaServersResource) Create(ctx context.Context, req resource.CreateRequ
 	state.MfaVendorType = var6
 	state.Name = types.StringValue(ans.Name)
 
+	// Keep the plaintext secrets instead of what the server returned.
+	state.SecretHashes = SaveSecrets(state.secrets(), plan.secrets())
+
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -914,6 +999,13 @@ func (r *mfaServersResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	var state mfaServersRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_mfa_servers", "read", state.Timeouts)
+	defer cancel()
 
 	// Basic logging.
 	tflog.Info(ctx, "performing resource read", map[string]any{
@@ -936,7 +1028,7 @@ func (r *mfaServersResource) Read(ctx context.Context, req resource.ReadRequest,
 		if IsObjectNotFound(err) {
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error reading config", err.Error())
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
 		}
 		return
 	}
@@ -996,6 +1088,15 @@ func (r *mfaServersResource) Read(ctx context.Context, req resource.ReadRequest,
 	state.MfaVendorType = var0
 	state.Name = types.StringValue(ans.Name)
 
+	// Keep the plaintext secrets if they are unchanged on the server.
+	var prior mfaServersRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.SecretHashes = RefreshSecrets(state.secrets(), prior.secrets(), prior.SecretHashes)
+	state.SecretVersion = prior.SecretVersion
+
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -1013,6 +1114,9 @@ func (r *mfaServersResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_mfa_servers", "update", plan.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource update", map[string]any{
 		"terraform_provider_function": "Update",
@@ -1080,11 +1184,12 @@ func (r *mfaServersResource) Update(ctx context.Context, req resource.UpdateRequ
 	// Perform the operation.
 	ans, err := svc.Update(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in update", err.Error())
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
 		return
 	}
 
 	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
 	var var6 *mfaServersRsModelMfaVendorTypeObject
 	if ans.MfaVendorType != nil {
 		var6 = &mfaServersRsModelMfaVendorTypeObject{}
@@ -1136,6 +1241,10 @@ func (r *mfaServersResource) Update(ctx context.Context, req resource.UpdateRequ
 	state.MfaVendorType = var6
 	state.Name = types.StringValue(ans.Name)
 
+	// Keep the plaintext secrets instead of what the server returned.
+	state.SecretHashes = SaveSecrets(state.secrets(), plan.secrets())
+	state.SecretVersion = plan.SecretVersion
+
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -1147,6 +1256,16 @@ func (r *mfaServersResource) Delete(ctx context.Context, req resource.DeleteRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_mfa_servers", "delete", timeouts)
+	defer cancel()
+
 	id := idType.ValueString()
 	tokens := strings.Split(id, IdSeparator)
 	if len(tokens) != 3 {
@@ -1169,10 +1288,61 @@ func (r *mfaServersResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	// Perform the operation.
 	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
-		resp.Diagnostics.AddError("Error in delete", err.Error())
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
 	}
 }
 
+// ImportState imports by either the resource ID or by location params and name,
+// such as `position=...,folder=...,name=...`.
 func (r *mfaServersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	params, ok, err := ParseImportId(req.ID, "position", "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_mfa_servers",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := wArkOsV.NewClient(r.client)
+	input := wArkOsV.ListInput{
+		Folder: params["folder"],
+		Name:   api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["position"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
 }
@@ -5,11 +5,19 @@ import (
 	"strings"
 
 	"github.com/paloaltonetworks/sase-go"
+	jNcXhRe "github.com/paloaltonetworks/sase-go/netsec/schema/mobile/agent/infrastructure/settings"
 	sefSZSA "github.com/paloaltonetworks/sase-go/netsec/service/v1/mobileagent/infrastructuresettings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rsschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -655,3 +663,1406 @@ func (d *mobileAgentInfrastructureSettingsListDataSource) Read(ctx context.Conte
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// Resource.
+var (
+	_ resource.Resource                     = &mobileAgentInfrastructureSettingsResource{}
+	_ resource.ResourceWithConfigure        = &mobileAgentInfrastructureSettingsResource{}
+	_ resource.ResourceWithImportState      = &mobileAgentInfrastructureSettingsResource{}
+	_ resource.ResourceWithConfigValidators = &mobileAgentInfrastructureSettingsResource{}
+)
+
+func NewMobileAgentInfrastructureSettingsResource() resource.Resource {
+	return &mobileAgentInfrastructureSettingsResource{}
+}
+
+type mobileAgentInfrastructureSettingsResource struct {
+	client *sase.Client
+}
+
+type mobileAgentInfrastructureSettingsRsModel struct {
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
+
+	// Input.
+	Folder types.String `tfsdk:"folder"`
+
+	// Request body input.
+	// Ref: #/components/schemas/mobile-agent-infrastructure-settings
+	DnsServers     []mobileAgentInfrastructureSettingsRsModelDnsServersObject   `tfsdk:"dns_servers"`
+	EnableWins     *mobileAgentInfrastructureSettingsRsModelEnableWinsObject    `tfsdk:"enable_wins"`
+	IpPools        []mobileAgentInfrastructureSettingsRsModelIpPoolsObject      `tfsdk:"ip_pools"`
+	Ipv6           types.Bool                                                   `tfsdk:"ipv6"`
+	Name           types.String                                                 `tfsdk:"name"`
+	PortalHostname mobileAgentInfrastructureSettingsRsModelPortalHostnameObject `tfsdk:"portal_hostname"`
+	RegionIpv6     mobileAgentInfrastructureSettingsRsModelRegionIpv6Object     `tfsdk:"region_ipv6"`
+	UdpQueries     *mobileAgentInfrastructureSettingsRsModelUdpQueriesObject    `tfsdk:"udp_queries"`
+}
+
+type mobileAgentInfrastructureSettingsRsModelDnsServersObject struct {
+	DnsSuffix          []types.String                                                    `tfsdk:"dns_suffix"`
+	InternalDnsMatch   []mobileAgentInfrastructureSettingsRsModelInternalDnsMatchObject  `tfsdk:"internal_dns_match"`
+	Name               types.String                                                      `tfsdk:"name"`
+	PrimaryPublicDns   *mobileAgentInfrastructureSettingsRsModelPrimaryPublicDnsObject   `tfsdk:"primary_public_dns"`
+	SecondaryPublicDns *mobileAgentInfrastructureSettingsRsModelSecondaryPublicDnsObject `tfsdk:"secondary_public_dns"`
+}
+
+type mobileAgentInfrastructureSettingsRsModelInternalDnsMatchObject struct {
+	DomainList []types.String                                           `tfsdk:"domain_list"`
+	Name       types.String                                             `tfsdk:"name"`
+	Primary    *mobileAgentInfrastructureSettingsRsModelPrimaryObject   `tfsdk:"primary"`
+	Secondary  *mobileAgentInfrastructureSettingsRsModelSecondaryObject `tfsdk:"secondary"`
+}
+
+type mobileAgentInfrastructureSettingsRsModelPrimaryObject struct {
+	DnsServer       types.Bool `tfsdk:"dns_server"`
+	UseCloudDefault types.Bool `tfsdk:"use_cloud_default"`
+}
+
+type mobileAgentInfrastructureSettingsRsModelSecondaryObject struct {
+	DnsServer       types.Bool `tfsdk:"dns_server"`
+	UseCloudDefault types.Bool `tfsdk:"use_cloud_default"`
+}
+
+type mobileAgentInfrastructureSettingsRsModelPrimaryPublicDnsObject struct {
+	DnsServer types.String `tfsdk:"dns_server"`
+}
+
+type mobileAgentInfrastructureSettingsRsModelSecondaryPublicDnsObject struct {
+	DnsServer types.String `tfsdk:"dns_server"`
+}
+
+type mobileAgentInfrastructureSettingsRsModelEnableWinsObject struct {
+	No  types.Bool                                         `tfsdk:"no"`
+	Yes *mobileAgentInfrastructureSettingsRsModelYesObject `tfsdk:"yes"`
+}
+
+type mobileAgentInfrastructureSettingsRsModelYesObject struct {
+	WinsServers []mobileAgentInfrastructureSettingsRsModelWinsServersObject `tfsdk:"wins_servers"`
+}
+
+type mobileAgentInfrastructureSettingsRsModelWinsServersObject struct {
+	Name      types.String `tfsdk:"name"`
+	Primary   types.String `tfsdk:"primary"`
+	Secondary types.String `tfsdk:"secondary"`
+}
+
+type mobileAgentInfrastructureSettingsRsModelIpPoolsObject struct {
+	IpPool []types.String `tfsdk:"ip_pool"`
+	Name   types.String   `tfsdk:"name"`
+}
+
+type mobileAgentInfrastructureSettingsRsModelPortalHostnameObject struct {
+	CustomDomain  *mobileAgentInfrastructureSettingsRsModelCustomDomainObject  `tfsdk:"custom_domain"`
+	DefaultDomain *mobileAgentInfrastructureSettingsRsModelDefaultDomainObject `tfsdk:"default_domain"`
+}
+
+type mobileAgentInfrastructureSettingsRsModelCustomDomainObject struct {
+	Cname                types.String `tfsdk:"cname"`
+	Hostname             types.String `tfsdk:"hostname"`
+	SslTlsServiceProfile types.String `tfsdk:"ssl_tls_service_profile"`
+}
+
+type mobileAgentInfrastructureSettingsRsModelDefaultDomainObject struct {
+	Hostname types.String `tfsdk:"hostname"`
+}
+
+type mobileAgentInfrastructureSettingsRsModelRegionIpv6Object struct {
+	Region []mobileAgentInfrastructureSettingsRsModelRegionObject `tfsdk:"region"`
+}
+
+type mobileAgentInfrastructureSettingsRsModelRegionObject struct {
+	Locations []types.String `tfsdk:"locations"`
+	Name      types.String   `tfsdk:"name"`
+}
+
+type mobileAgentInfrastructureSettingsRsModelUdpQueriesObject struct {
+	Retries *mobileAgentInfrastructureSettingsRsModelRetriesObject `tfsdk:"retries"`
+}
+
+type mobileAgentInfrastructureSettingsRsModelRetriesObject struct {
+	Attempts types.Int64 `tfsdk:"attempts"`
+	Interval types.Int64 `tfsdk:"interval"`
+}
+
+// Metadata returns the data source type name.
+func (r *mobileAgentInfrastructureSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mobile_agent_infrastructure_settings"
+}
+
+// Schema defines the schema for this listing data source.
+func (r *mobileAgentInfrastructureSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rsschema.Schema{
+		Description: "Manages the GlobalProtect mobile agent infrastructure settings, keyed by name. The settings can't be deleted, so deleting this resource only removes it from the state.",
+
+		Attributes: map[string]rsschema.Attribute{
+			"id": rsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Input.
+			"folder": rsschema.StringAttribute{
+				Description:         "The folder of the entry. Value must be one of: `\"Mobile Users\"`.",
+				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Mobile Users\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Mobile Users"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"dns_servers": rsschema.ListNestedAttribute{
+				Description:         "The `dns_servers` parameter.",
+				MarkdownDescription: "The `dns_servers` parameter.",
+				Optional:            true,
+				NestedObject: rsschema.NestedAttributeObject{
+					Attributes: map[string]rsschema.Attribute{
+						"dns_suffix": rsschema.ListAttribute{
+							Description:         "The `dns_suffix` parameter.",
+							MarkdownDescription: "The `dns_suffix` parameter.",
+							Optional:            true,
+							ElementType:         types.StringType,
+						},
+						"internal_dns_match": rsschema.ListNestedAttribute{
+							Description:         "The `internal_dns_match` parameter.",
+							MarkdownDescription: "The `internal_dns_match` parameter.",
+							Optional:            true,
+							NestedObject: rsschema.NestedAttributeObject{
+								Attributes: map[string]rsschema.Attribute{
+									"domain_list": rsschema.ListAttribute{
+										Description:         "The `domain_list` parameter.",
+										MarkdownDescription: "The `domain_list` parameter.",
+										Optional:            true,
+										ElementType:         types.StringType,
+									},
+									"name": rsschema.StringAttribute{
+										Description:         "The `name` parameter.",
+										MarkdownDescription: "The `name` parameter.",
+										Required:            true,
+									},
+									"primary": rsschema.SingleNestedAttribute{
+										Description:         "The `primary` parameter.",
+										MarkdownDescription: "The `primary` parameter.",
+										Optional:            true,
+										Attributes: map[string]rsschema.Attribute{
+											"dns_server": rsschema.BoolAttribute{
+												Description:         "The `dns_server` parameter.",
+												MarkdownDescription: "The `dns_server` parameter.",
+												Optional:            true,
+											},
+											"use_cloud_default": rsschema.BoolAttribute{
+												Description:         "The `use_cloud_default` parameter.",
+												MarkdownDescription: "The `use_cloud_default` parameter.",
+												Optional:            true,
+											},
+										},
+									},
+									"secondary": rsschema.SingleNestedAttribute{
+										Description:         "The `secondary` parameter.",
+										MarkdownDescription: "The `secondary` parameter.",
+										Optional:            true,
+										Attributes: map[string]rsschema.Attribute{
+											"dns_server": rsschema.BoolAttribute{
+												Description:         "The `dns_server` parameter.",
+												MarkdownDescription: "The `dns_server` parameter.",
+												Optional:            true,
+											},
+											"use_cloud_default": rsschema.BoolAttribute{
+												Description:         "The `use_cloud_default` parameter.",
+												MarkdownDescription: "The `use_cloud_default` parameter.",
+												Optional:            true,
+											},
+										},
+									},
+								},
+							},
+						},
+						"name": rsschema.StringAttribute{
+							Description:         "The `name` parameter.",
+							MarkdownDescription: "The `name` parameter.",
+							Required:            true,
+						},
+						"primary_public_dns": rsschema.SingleNestedAttribute{
+							Description:         "The `primary_public_dns` parameter.",
+							MarkdownDescription: "The `primary_public_dns` parameter.",
+							Optional:            true,
+							Attributes: map[string]rsschema.Attribute{
+								"dns_server": rsschema.StringAttribute{
+									Description:         "The `dns_server` parameter. Value must be an IPv4 or IPv6 address.",
+									MarkdownDescription: "The `dns_server` parameter. Value must be an IPv4 or IPv6 address.",
+									Optional:            true,
+									Computed:            true,
+									PlanModifiers: []planmodifier.String{
+										DefaultString(""),
+									},
+									Validators: []validator.String{
+										IsIpAddress(),
+									},
+								},
+							},
+						},
+						"secondary_public_dns": rsschema.SingleNestedAttribute{
+							Description:         "The `secondary_public_dns` parameter.",
+							MarkdownDescription: "The `secondary_public_dns` parameter.",
+							Optional:            true,
+							Attributes: map[string]rsschema.Attribute{
+								"dns_server": rsschema.StringAttribute{
+									Description:         "The `dns_server` parameter. Value must be an IPv4 or IPv6 address.",
+									MarkdownDescription: "The `dns_server` parameter. Value must be an IPv4 or IPv6 address.",
+									Optional:            true,
+									Computed:            true,
+									PlanModifiers: []planmodifier.String{
+										DefaultString(""),
+									},
+									Validators: []validator.String{
+										IsIpAddress(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"enable_wins": rsschema.SingleNestedAttribute{
+				Description:         "The `enable_wins` parameter.",
+				MarkdownDescription: "The `enable_wins` parameter.",
+				Optional:            true,
+				Attributes: map[string]rsschema.Attribute{
+					"no": rsschema.BoolAttribute{
+						Description:         "The `no` parameter.",
+						MarkdownDescription: "The `no` parameter.",
+						Optional:            true,
+					},
+					"yes": rsschema.SingleNestedAttribute{
+						Description:         "The `yes` parameter.",
+						MarkdownDescription: "The `yes` parameter.",
+						Optional:            true,
+						Attributes: map[string]rsschema.Attribute{
+							"wins_servers": rsschema.ListNestedAttribute{
+								Description:         "The `wins_servers` parameter.",
+								MarkdownDescription: "The `wins_servers` parameter.",
+								Optional:            true,
+								NestedObject: rsschema.NestedAttributeObject{
+									Attributes: map[string]rsschema.Attribute{
+										"name": rsschema.StringAttribute{
+											Description:         "The `name` parameter.",
+											MarkdownDescription: "The `name` parameter.",
+											Required:            true,
+										},
+										"primary": rsschema.StringAttribute{
+											Description:         "The `primary` parameter. Value must be an IPv4 or IPv6 address.",
+											MarkdownDescription: "The `primary` parameter. Value must be an IPv4 or IPv6 address.",
+											Optional:            true,
+											Computed:            true,
+											PlanModifiers: []planmodifier.String{
+												DefaultString(""),
+											},
+											Validators: []validator.String{
+												IsIpAddress(),
+											},
+										},
+										"secondary": rsschema.StringAttribute{
+											Description:         "The `secondary` parameter. Value must be an IPv4 or IPv6 address.",
+											MarkdownDescription: "The `secondary` parameter. Value must be an IPv4 or IPv6 address.",
+											Optional:            true,
+											Computed:            true,
+											PlanModifiers: []planmodifier.String{
+												DefaultString(""),
+											},
+											Validators: []validator.String{
+												IsIpAddress(),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"ip_pools": rsschema.ListNestedAttribute{
+				Description:         "The `ip_pools` parameter.",
+				MarkdownDescription: "The `ip_pools` parameter.",
+				Optional:            true,
+				NestedObject: rsschema.NestedAttributeObject{
+					Attributes: map[string]rsschema.Attribute{
+						"ip_pool": rsschema.ListAttribute{
+							Description:         "The `ip_pool` parameter. Each value must be an IPv4 or IPv6 prefix in CIDR notation.",
+							MarkdownDescription: "The `ip_pool` parameter. Each value must be an IPv4 or IPv6 prefix in CIDR notation.",
+							Required:            true,
+							ElementType:         types.StringType,
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(IsIpPrefix()),
+							},
+						},
+						"name": rsschema.StringAttribute{
+							Description:         "The `name` parameter.",
+							MarkdownDescription: "The `name` parameter.",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"ipv6": rsschema.BoolAttribute{
+				Description:         "The `ipv6` parameter.",
+				MarkdownDescription: "The `ipv6` parameter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					DefaultBool(false),
+				},
+			},
+			"name": rsschema.StringAttribute{
+				Description:         "The `name` parameter.",
+				MarkdownDescription: "The `name` parameter.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"portal_hostname": rsschema.SingleNestedAttribute{
+				Description:         "The `portal_hostname` parameter.",
+				MarkdownDescription: "The `portal_hostname` parameter.",
+				Required:            true,
+				Attributes: map[string]rsschema.Attribute{
+					"custom_domain": rsschema.SingleNestedAttribute{
+						Description:         "The `custom_domain` parameter.",
+						MarkdownDescription: "The `custom_domain` parameter.",
+						Optional:            true,
+						Attributes: map[string]rsschema.Attribute{
+							"cname": rsschema.StringAttribute{
+								Description:         "The `cname` parameter.",
+								MarkdownDescription: "The `cname` parameter.",
+								Optional:            true,
+								Computed:            true,
+								PlanModifiers: []planmodifier.String{
+									DefaultString(""),
+								},
+							},
+							"hostname": rsschema.StringAttribute{
+								Description:         "The `hostname` parameter. Value must be a fully qualified domain name.",
+								MarkdownDescription: "The `hostname` parameter. Value must be a fully qualified domain name.",
+								Required:            true,
+								Validators: []validator.String{
+									IsFqdn(),
+								},
+							},
+							"ssl_tls_service_profile": rsschema.StringAttribute{
+								Description:         "The `ssl_tls_service_profile` parameter.",
+								MarkdownDescription: "The `ssl_tls_service_profile` parameter.",
+								Optional:            true,
+								Computed:            true,
+								PlanModifiers: []planmodifier.String{
+									DefaultString(""),
+								},
+							},
+						},
+					},
+					"default_domain": rsschema.SingleNestedAttribute{
+						Description:         "The `default_domain` parameter.",
+						MarkdownDescription: "The `default_domain` parameter.",
+						Optional:            true,
+						Attributes: map[string]rsschema.Attribute{
+							"hostname": rsschema.StringAttribute{
+								Description:         "The `hostname` parameter.",
+								MarkdownDescription: "The `hostname` parameter.",
+								Required:            true,
+							},
+						},
+					},
+				},
+			},
+			"region_ipv6": rsschema.SingleNestedAttribute{
+				Description:         "The `region_ipv6` parameter.",
+				MarkdownDescription: "The `region_ipv6` parameter.",
+				Required:            true,
+				Attributes: map[string]rsschema.Attribute{
+					"region": rsschema.ListNestedAttribute{
+						Description:         "The `region` parameter.",
+						MarkdownDescription: "The `region` parameter.",
+						Optional:            true,
+						NestedObject: rsschema.NestedAttributeObject{
+							Attributes: map[string]rsschema.Attribute{
+								"locations": rsschema.ListAttribute{
+									Description:         "The `locations` parameter.",
+									MarkdownDescription: "The `locations` parameter.",
+									Optional:            true,
+									ElementType:         types.StringType,
+								},
+								"name": rsschema.StringAttribute{
+									Description:         "The `name` parameter.",
+									MarkdownDescription: "The `name` parameter.",
+									Required:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"udp_queries": rsschema.SingleNestedAttribute{
+				Description:         "The `udp_queries` parameter.",
+				MarkdownDescription: "The `udp_queries` parameter.",
+				Optional:            true,
+				Attributes: map[string]rsschema.Attribute{
+					"retries": rsschema.SingleNestedAttribute{
+						Description:         "The `retries` parameter.",
+						MarkdownDescription: "The `retries` parameter.",
+						Optional:            true,
+						Attributes: map[string]rsschema.Attribute{
+							"attempts": rsschema.Int64Attribute{
+								Description:         "The `attempts` parameter. Default: `5`. Value must be between 1 and 30.",
+								MarkdownDescription: "The `attempts` parameter. Default: `5`. Value must be between 1 and 30.",
+								Optional:            true,
+								Computed:            true,
+								PlanModifiers: []planmodifier.Int64{
+									DefaultInt64(5),
+								},
+								Validators: []validator.Int64{
+									int64validator.Between(1, 30),
+								},
+							},
+							"interval": rsschema.Int64Attribute{
+								Description:         "The `interval` parameter. Default: `2`. Value must be between 1 and 30.",
+								MarkdownDescription: "The `interval` parameter. Default: `2`. Value must be between 1 and 30.",
+								Optional:            true,
+								Computed:            true,
+								PlanModifiers: []planmodifier.Int64{
+									DefaultInt64(2),
+								},
+								Validators: []validator.Int64{
+									int64validator.Between(1, 30),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_mobile_agent_infrastructure_settings"),
+		},
+	}
+}
+
+// ConfigValidators returns the checks for mutually exclusive attributes.
+func (r *mobileAgentInfrastructureSettingsResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		ExactlyOneOfNested(path.MatchRoot("enable_wins"), "no", "yes"),
+		ExactlyOneOfNested(path.MatchRoot("portal_hostname"), "custom_domain", "default_domain"),
+	}
+}
+
+// Configure prepares the struct.
+func (r *mobileAgentInfrastructureSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*sase.Client)
+}
+
+// Create resource
+func (r *mobileAgentInfrastructureSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state mobileAgentInfrastructureSettingsRsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_mobile_agent_infrastructure_settings", "create", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource create", map[string]any{
+		"terraform_provider_function": "Create",
+		"resource_name":               "sase_mobile_agent_infrastructure_settings",
+		"folder":                      state.Folder.ValueString(),
+		"name":                        state.Name.ValueString(),
+	})
+
+	// The settings can't be deleted, so settings with this name may already
+	// exist from an earlier rollout.  If so, take them over with an update.
+	svc := sefSZSA.NewClient(r.client)
+	list, err := svc.List(ctx, sefSZSA.ListInput{
+		Folder: state.Folder.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", OperationError(ctx, err))
+		return
+	}
+	exists := false
+	for _, x := range list.Data {
+		if x.Name == state.Name.ValueString() {
+			exists = true
+			break
+		}
+	}
+
+	// Prepare to create the config.
+	var var0 jNcXhRe.Config
+	var var1 []jNcXhRe.DnsServersObject
+	if len(state.DnsServers) != 0 {
+		var1 = make([]jNcXhRe.DnsServersObject, 0, len(state.DnsServers))
+		for var2Index := range state.DnsServers {
+			var2 := state.DnsServers[var2Index]
+			var var3 jNcXhRe.DnsServersObject
+			var3.DnsSuffix = DecodeStringSlice(var2.DnsSuffix)
+			var var4 []jNcXhRe.InternalDnsMatchObject
+			if len(var2.InternalDnsMatch) != 0 {
+				var4 = make([]jNcXhRe.InternalDnsMatchObject, 0, len(var2.InternalDnsMatch))
+				for var5Index := range var2.InternalDnsMatch {
+					var5 := var2.InternalDnsMatch[var5Index]
+					var var6 jNcXhRe.InternalDnsMatchObject
+					var6.DomainList = DecodeStringSlice(var5.DomainList)
+					var6.Name = var5.Name.ValueString()
+					var var7 *jNcXhRe.PrimaryObject
+					if var5.Primary != nil {
+						var7 = &jNcXhRe.PrimaryObject{}
+						if var5.Primary.DnsServer.ValueBool() {
+							var7.DnsServer = struct{}{}
+						}
+						if var5.Primary.UseCloudDefault.ValueBool() {
+							var7.UseCloudDefault = struct{}{}
+						}
+					}
+					var6.Primary = var7
+					var var8 *jNcXhRe.SecondaryObject
+					if var5.Secondary != nil {
+						var8 = &jNcXhRe.SecondaryObject{}
+						if var5.Secondary.DnsServer.ValueBool() {
+							var8.DnsServer = struct{}{}
+						}
+						if var5.Secondary.UseCloudDefault.ValueBool() {
+							var8.UseCloudDefault = struct{}{}
+						}
+					}
+					var6.Secondary = var8
+					var4 = append(var4, var6)
+				}
+			}
+			var3.InternalDnsMatch = var4
+			var3.Name = var2.Name.ValueString()
+			var var9 *jNcXhRe.PrimaryPublicDnsObject
+			if var2.PrimaryPublicDns != nil {
+				var9 = &jNcXhRe.PrimaryPublicDnsObject{}
+				var9.DnsServer = var2.PrimaryPublicDns.DnsServer.ValueString()
+			}
+			var3.PrimaryPublicDns = var9
+			var var10 *jNcXhRe.SecondaryPublicDnsObject
+			if var2.SecondaryPublicDns != nil {
+				var10 = &jNcXhRe.SecondaryPublicDnsObject{}
+				var10.DnsServer = var2.SecondaryPublicDns.DnsServer.ValueString()
+			}
+			var3.SecondaryPublicDns = var10
+			var1 = append(var1, var3)
+		}
+	}
+	var0.DnsServers = var1
+	var var11 *jNcXhRe.EnableWinsObject
+	if state.EnableWins != nil {
+		var11 = &jNcXhRe.EnableWinsObject{}
+		if state.EnableWins.No.ValueBool() {
+			var11.No = struct{}{}
+		}
+		var var12 *jNcXhRe.YesObject
+		if state.EnableWins.Yes != nil {
+			var12 = &jNcXhRe.YesObject{}
+			var var13 []jNcXhRe.WinsServersObject
+			if len(state.EnableWins.Yes.WinsServers) != 0 {
+				var13 = make([]jNcXhRe.WinsServersObject, 0, len(state.EnableWins.Yes.WinsServers))
+				for var14Index := range state.EnableWins.Yes.WinsServers {
+					var14 := state.EnableWins.Yes.WinsServers[var14Index]
+					var var15 jNcXhRe.WinsServersObject
+					var15.Name = var14.Name.ValueString()
+					var15.Primary = var14.Primary.ValueString()
+					var15.Secondary = var14.Secondary.ValueString()
+					var13 = append(var13, var15)
+				}
+			}
+			var12.WinsServers = var13
+		}
+		var11.Yes = var12
+	}
+	var0.EnableWins = var11
+	var var16 []jNcXhRe.IpPoolsObject
+	if len(state.IpPools) != 0 {
+		var16 = make([]jNcXhRe.IpPoolsObject, 0, len(state.IpPools))
+		for var17Index := range state.IpPools {
+			var17 := state.IpPools[var17Index]
+			var var18 jNcXhRe.IpPoolsObject
+			var18.IpPool = DecodeStringSlice(var17.IpPool)
+			var18.Name = var17.Name.ValueString()
+			var16 = append(var16, var18)
+		}
+	}
+	var0.IpPools = var16
+	var0.Ipv6 = state.Ipv6.ValueBool()
+	var0.Name = state.Name.ValueString()
+	var var19 jNcXhRe.PortalHostnameObject
+	var var20 *jNcXhRe.CustomDomainObject
+	if state.PortalHostname.CustomDomain != nil {
+		var20 = &jNcXhRe.CustomDomainObject{}
+		var20.Cname = state.PortalHostname.CustomDomain.Cname.ValueString()
+		var20.Hostname = state.PortalHostname.CustomDomain.Hostname.ValueString()
+		var20.SslTlsServiceProfile = state.PortalHostname.CustomDomain.SslTlsServiceProfile.ValueString()
+	}
+	var19.CustomDomain = var20
+	var var21 *jNcXhRe.DefaultDomainObject
+	if state.PortalHostname.DefaultDomain != nil {
+		var21 = &jNcXhRe.DefaultDomainObject{}
+		var21.Hostname = state.PortalHostname.DefaultDomain.Hostname.ValueString()
+	}
+	var19.DefaultDomain = var21
+	var0.PortalHostname = var19
+	var var22 jNcXhRe.RegionIpv6Object
+	var var23 []jNcXhRe.RegionObject
+	if len(state.RegionIpv6.Region) != 0 {
+		var23 = make([]jNcXhRe.RegionObject, 0, len(state.RegionIpv6.Region))
+		for var24Index := range state.RegionIpv6.Region {
+			var24 := state.RegionIpv6.Region[var24Index]
+			var var25 jNcXhRe.RegionObject
+			var25.Locations = DecodeStringSlice(var24.Locations)
+			var25.Name = var24.Name.ValueString()
+			var23 = append(var23, var25)
+		}
+	}
+	var22.Region = var23
+	var0.RegionIpv6 = var22
+	var var26 *jNcXhRe.UdpQueriesObject
+	if state.UdpQueries != nil {
+		var26 = &jNcXhRe.UdpQueriesObject{}
+		var var27 *jNcXhRe.RetriesObject
+		if state.UdpQueries.Retries != nil {
+			var27 = &jNcXhRe.RetriesObject{}
+			var27.Attempts = state.UdpQueries.Retries.Attempts.ValueInt64()
+			var27.Interval = state.UdpQueries.Retries.Interval.ValueInt64()
+		}
+		var26.Retries = var27
+	}
+	var0.UdpQueries = var26
+
+	// Perform the operation.
+	var ans jNcXhRe.Config
+	if exists {
+		ans, err = svc.Update(ctx, sefSZSA.UpdateInput{
+			Folder: state.Folder.ValueString(),
+			Config: var0,
+		})
+	} else {
+		ans, err = svc.Create(ctx, sefSZSA.CreateInput{
+			Folder: state.Folder.ValueString(),
+			Config: var0,
+		})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(state.Folder.ValueString())
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(ans.Name)
+	state.Id = types.StringValue(idBuilder.String())
+	var var28 []mobileAgentInfrastructureSettingsRsModelDnsServersObject
+	if len(ans.DnsServers) != 0 {
+		var28 = make([]mobileAgentInfrastructureSettingsRsModelDnsServersObject, 0, len(ans.DnsServers))
+		for var29Index := range ans.DnsServers {
+			var29 := ans.DnsServers[var29Index]
+			var var30 mobileAgentInfrastructureSettingsRsModelDnsServersObject
+			var var31 []mobileAgentInfrastructureSettingsRsModelInternalDnsMatchObject
+			if len(var29.InternalDnsMatch) != 0 {
+				var31 = make([]mobileAgentInfrastructureSettingsRsModelInternalDnsMatchObject, 0, len(var29.InternalDnsMatch))
+				for var32Index := range var29.InternalDnsMatch {
+					var32 := var29.InternalDnsMatch[var32Index]
+					var var33 mobileAgentInfrastructureSettingsRsModelInternalDnsMatchObject
+					var var34 *mobileAgentInfrastructureSettingsRsModelPrimaryObject
+					if var32.Primary != nil {
+						var34 = &mobileAgentInfrastructureSettingsRsModelPrimaryObject{}
+						if var32.Primary.DnsServer != nil {
+							var34.DnsServer = types.BoolValue(true)
+						}
+						if var32.Primary.UseCloudDefault != nil {
+							var34.UseCloudDefault = types.BoolValue(true)
+						}
+					}
+					var var35 *mobileAgentInfrastructureSettingsRsModelSecondaryObject
+					if var32.Secondary != nil {
+						var35 = &mobileAgentInfrastructureSettingsRsModelSecondaryObject{}
+						if var32.Secondary.DnsServer != nil {
+							var35.DnsServer = types.BoolValue(true)
+						}
+						if var32.Secondary.UseCloudDefault != nil {
+							var35.UseCloudDefault = types.BoolValue(true)
+						}
+					}
+					var33.DomainList = EncodeStringSlice(var32.DomainList)
+					var33.Name = types.StringValue(var32.Name)
+					var33.Primary = var34
+					var33.Secondary = var35
+					var31 = append(var31, var33)
+				}
+			}
+			var var36 *mobileAgentInfrastructureSettingsRsModelPrimaryPublicDnsObject
+			if var29.PrimaryPublicDns != nil {
+				var36 = &mobileAgentInfrastructureSettingsRsModelPrimaryPublicDnsObject{}
+				var36.DnsServer = types.StringValue(var29.PrimaryPublicDns.DnsServer)
+			}
+			var var37 *mobileAgentInfrastructureSettingsRsModelSecondaryPublicDnsObject
+			if var29.SecondaryPublicDns != nil {
+				var37 = &mobileAgentInfrastructureSettingsRsModelSecondaryPublicDnsObject{}
+				var37.DnsServer = types.StringValue(var29.SecondaryPublicDns.DnsServer)
+			}
+			var30.DnsSuffix = EncodeStringSlice(var29.DnsSuffix)
+			var30.InternalDnsMatch = var31
+			var30.Name = types.StringValue(var29.Name)
+			var30.PrimaryPublicDns = var36
+			var30.SecondaryPublicDns = var37
+			var28 = append(var28, var30)
+		}
+	}
+	var var38 *mobileAgentInfrastructureSettingsRsModelEnableWinsObject
+	if ans.EnableWins != nil {
+		var38 = &mobileAgentInfrastructureSettingsRsModelEnableWinsObject{}
+		var var39 *mobileAgentInfrastructureSettingsRsModelYesObject
+		if ans.EnableWins.Yes != nil {
+			var39 = &mobileAgentInfrastructureSettingsRsModelYesObject{}
+			var var40 []mobileAgentInfrastructureSettingsRsModelWinsServersObject
+			if len(ans.EnableWins.Yes.WinsServers) != 0 {
+				var40 = make([]mobileAgentInfrastructureSettingsRsModelWinsServersObject, 0, len(ans.EnableWins.Yes.WinsServers))
+				for var41Index := range ans.EnableWins.Yes.WinsServers {
+					var41 := ans.EnableWins.Yes.WinsServers[var41Index]
+					var var42 mobileAgentInfrastructureSettingsRsModelWinsServersObject
+					var42.Name = types.StringValue(var41.Name)
+					var42.Primary = types.StringValue(var41.Primary)
+					var42.Secondary = types.StringValue(var41.Secondary)
+					var40 = append(var40, var42)
+				}
+			}
+			var39.WinsServers = var40
+		}
+		if ans.EnableWins.No != nil {
+			var38.No = types.BoolValue(true)
+		}
+		var38.Yes = var39
+	}
+	var var43 []mobileAgentInfrastructureSettingsRsModelIpPoolsObject
+	if len(ans.IpPools) != 0 {
+		var43 = make([]mobileAgentInfrastructureSettingsRsModelIpPoolsObject, 0, len(ans.IpPools))
+		for var44Index := range ans.IpPools {
+			var44 := ans.IpPools[var44Index]
+			var var45 mobileAgentInfrastructureSettingsRsModelIpPoolsObject
+			var45.IpPool = EncodeStringSlice(var44.IpPool)
+			var45.Name = types.StringValue(var44.Name)
+			var43 = append(var43, var45)
+		}
+	}
+	var var46 mobileAgentInfrastructureSettingsRsModelPortalHostnameObject
+	var var47 *mobileAgentInfrastructureSettingsRsModelCustomDomainObject
+	if ans.PortalHostname.CustomDomain != nil {
+		var47 = &mobileAgentInfrastructureSettingsRsModelCustomDomainObject{}
+		var47.Cname = types.StringValue(ans.PortalHostname.CustomDomain.Cname)
+		var47.Hostname = types.StringValue(ans.PortalHostname.CustomDomain.Hostname)
+		var47.SslTlsServiceProfile = types.StringValue(ans.PortalHostname.CustomDomain.SslTlsServiceProfile)
+	}
+	var var48 *mobileAgentInfrastructureSettingsRsModelDefaultDomainObject
+	if ans.PortalHostname.DefaultDomain != nil {
+		var48 = &mobileAgentInfrastructureSettingsRsModelDefaultDomainObject{}
+		var48.Hostname = types.StringValue(ans.PortalHostname.DefaultDomain.Hostname)
+	}
+	var46.CustomDomain = var47
+	var46.DefaultDomain = var48
+	var var49 mobileAgentInfrastructureSettingsRsModelRegionIpv6Object
+	var var50 []mobileAgentInfrastructureSettingsRsModelRegionObject
+	if len(ans.RegionIpv6.Region) != 0 {
+		var50 = make([]mobileAgentInfrastructureSettingsRsModelRegionObject, 0, len(ans.RegionIpv6.Region))
+		for var51Index := range ans.RegionIpv6.Region {
+			var51 := ans.RegionIpv6.Region[var51Index]
+			var var52 mobileAgentInfrastructureSettingsRsModelRegionObject
+			var52.Locations = EncodeStringSlice(var51.Locations)
+			var52.Name = types.StringValue(var51.Name)
+			var50 = append(var50, var52)
+		}
+	}
+	var49.Region = var50
+	var var53 *mobileAgentInfrastructureSettingsRsModelUdpQueriesObject
+	if ans.UdpQueries != nil {
+		var53 = &mobileAgentInfrastructureSettingsRsModelUdpQueriesObject{}
+		var var54 *mobileAgentInfrastructureSettingsRsModelRetriesObject
+		if ans.UdpQueries.Retries != nil {
+			var54 = &mobileAgentInfrastructureSettingsRsModelRetriesObject{}
+			var54.Attempts = types.Int64Value(ans.UdpQueries.Retries.Attempts)
+			var54.Interval = types.Int64Value(ans.UdpQueries.Retries.Interval)
+		}
+		var53.Retries = var54
+	}
+	state.DnsServers = var28
+	state.EnableWins = var38
+	state.IpPools = var43
+	state.Ipv6 = types.BoolValue(ans.Ipv6)
+	state.Name = types.StringValue(ans.Name)
+	state.PortalHostname = var46
+	state.RegionIpv6 = var49
+	state.UdpQueries = var53
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read resource.
+func (r *mobileAgentInfrastructureSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var idType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &idType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := idType.ValueString()
+	tokens := strings.Split(id, IdSeparator)
+	if len(tokens) != 2 {
+		resp.Diagnostics.AddError("Error in resource ID format", "Expected 2 tokens")
+		return
+	}
+
+	var state mobileAgentInfrastructureSettingsRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_mobile_agent_infrastructure_settings", "read", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource read", map[string]any{
+		"terraform_provider_function": "Read",
+		"resource_name":               "sase_mobile_agent_infrastructure_settings",
+		"locMap":                      map[string]int{"Folder": 0, "Name": 1},
+		"tokens":                      tokens,
+	})
+
+	// Prepare to read the config.
+	svc := sefSZSA.NewClient(r.client)
+	input := sefSZSA.ListInput{
+		Folder: tokens[0],
+	}
+
+	// Perform the operation.
+	list, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
+		return
+	}
+	var ans *jNcXhRe.Config
+	for i := range list.Data {
+		if list.Data[i].Name == tokens[1] {
+			ans = &list.Data[i]
+			break
+		}
+	}
+	if ans == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Store the answer to state.
+	state.Id = idType
+	state.Folder = types.StringValue(tokens[0])
+	var var0 []mobileAgentInfrastructureSettingsRsModelDnsServersObject
+	if len(ans.DnsServers) != 0 {
+		var0 = make([]mobileAgentInfrastructureSettingsRsModelDnsServersObject, 0, len(ans.DnsServers))
+		for var1Index := range ans.DnsServers {
+			var1 := ans.DnsServers[var1Index]
+			var var2 mobileAgentInfrastructureSettingsRsModelDnsServersObject
+			var var3 []mobileAgentInfrastructureSettingsRsModelInternalDnsMatchObject
+			if len(var1.InternalDnsMatch) != 0 {
+				var3 = make([]mobileAgentInfrastructureSettingsRsModelInternalDnsMatchObject, 0, len(var1.InternalDnsMatch))
+				for var4Index := range var1.InternalDnsMatch {
+					var4 := var1.InternalDnsMatch[var4Index]
+					var var5 mobileAgentInfrastructureSettingsRsModelInternalDnsMatchObject
+					var var6 *mobileAgentInfrastructureSettingsRsModelPrimaryObject
+					if var4.Primary != nil {
+						var6 = &mobileAgentInfrastructureSettingsRsModelPrimaryObject{}
+						if var4.Primary.DnsServer != nil {
+							var6.DnsServer = types.BoolValue(true)
+						}
+						if var4.Primary.UseCloudDefault != nil {
+							var6.UseCloudDefault = types.BoolValue(true)
+						}
+					}
+					var var7 *mobileAgentInfrastructureSettingsRsModelSecondaryObject
+					if var4.Secondary != nil {
+						var7 = &mobileAgentInfrastructureSettingsRsModelSecondaryObject{}
+						if var4.Secondary.DnsServer != nil {
+							var7.DnsServer = types.BoolValue(true)
+						}
+						if var4.Secondary.UseCloudDefault != nil {
+							var7.UseCloudDefault = types.BoolValue(true)
+						}
+					}
+					var5.DomainList = EncodeStringSlice(var4.DomainList)
+					var5.Name = types.StringValue(var4.Name)
+					var5.Primary = var6
+					var5.Secondary = var7
+					var3 = append(var3, var5)
+				}
+			}
+			var var8 *mobileAgentInfrastructureSettingsRsModelPrimaryPublicDnsObject
+			if var1.PrimaryPublicDns != nil {
+				var8 = &mobileAgentInfrastructureSettingsRsModelPrimaryPublicDnsObject{}
+				var8.DnsServer = types.StringValue(var1.PrimaryPublicDns.DnsServer)
+			}
+			var var9 *mobileAgentInfrastructureSettingsRsModelSecondaryPublicDnsObject
+			if var1.SecondaryPublicDns != nil {
+				var9 = &mobileAgentInfrastructureSettingsRsModelSecondaryPublicDnsObject{}
+				var9.DnsServer = types.StringValue(var1.SecondaryPublicDns.DnsServer)
+			}
+			var2.DnsSuffix = EncodeStringSlice(var1.DnsSuffix)
+			var2.InternalDnsMatch = var3
+			var2.Name = types.StringValue(var1.Name)
+			var2.PrimaryPublicDns = var8
+			var2.SecondaryPublicDns = var9
+			var0 = append(var0, var2)
+		}
+	}
+	var var10 *mobileAgentInfrastructureSettingsRsModelEnableWinsObject
+	if ans.EnableWins != nil {
+		var10 = &mobileAgentInfrastructureSettingsRsModelEnableWinsObject{}
+		var var11 *mobileAgentInfrastructureSettingsRsModelYesObject
+		if ans.EnableWins.Yes != nil {
+			var11 = &mobileAgentInfrastructureSettingsRsModelYesObject{}
+			var var12 []mobileAgentInfrastructureSettingsRsModelWinsServersObject
+			if len(ans.EnableWins.Yes.WinsServers) != 0 {
+				var12 = make([]mobileAgentInfrastructureSettingsRsModelWinsServersObject, 0, len(ans.EnableWins.Yes.WinsServers))
+				for var13Index := range ans.EnableWins.Yes.WinsServers {
+					var13 := ans.EnableWins.Yes.WinsServers[var13Index]
+					var var14 mobileAgentInfrastructureSettingsRsModelWinsServersObject
+					var14.Name = types.StringValue(var13.Name)
+					var14.Primary = types.StringValue(var13.Primary)
+					var14.Secondary = types.StringValue(var13.Secondary)
+					var12 = append(var12, var14)
+				}
+			}
+			var11.WinsServers = var12
+		}
+		if ans.EnableWins.No != nil {
+			var10.No = types.BoolValue(true)
+		}
+		var10.Yes = var11
+	}
+	var var15 []mobileAgentInfrastructureSettingsRsModelIpPoolsObject
+	if len(ans.IpPools) != 0 {
+		var15 = make([]mobileAgentInfrastructureSettingsRsModelIpPoolsObject, 0, len(ans.IpPools))
+		for var16Index := range ans.IpPools {
+			var16 := ans.IpPools[var16Index]
+			var var17 mobileAgentInfrastructureSettingsRsModelIpPoolsObject
+			var17.IpPool = EncodeStringSlice(var16.IpPool)
+			var17.Name = types.StringValue(var16.Name)
+			var15 = append(var15, var17)
+		}
+	}
+	var var18 mobileAgentInfrastructureSettingsRsModelPortalHostnameObject
+	var var19 *mobileAgentInfrastructureSettingsRsModelCustomDomainObject
+	if ans.PortalHostname.CustomDomain != nil {
+		var19 = &mobileAgentInfrastructureSettingsRsModelCustomDomainObject{}
+		var19.Cname = types.StringValue(ans.PortalHostname.CustomDomain.Cname)
+		var19.Hostname = types.StringValue(ans.PortalHostname.CustomDomain.Hostname)
+		var19.SslTlsServiceProfile = types.StringValue(ans.PortalHostname.CustomDomain.SslTlsServiceProfile)
+	}
+	var var20 *mobileAgentInfrastructureSettingsRsModelDefaultDomainObject
+	if ans.PortalHostname.DefaultDomain != nil {
+		var20 = &mobileAgentInfrastructureSettingsRsModelDefaultDomainObject{}
+		var20.Hostname = types.StringValue(ans.PortalHostname.DefaultDomain.Hostname)
+	}
+	var18.CustomDomain = var19
+	var18.DefaultDomain = var20
+	var var21 mobileAgentInfrastructureSettingsRsModelRegionIpv6Object
+	var var22 []mobileAgentInfrastructureSettingsRsModelRegionObject
+	if len(ans.RegionIpv6.Region) != 0 {
+		var22 = make([]mobileAgentInfrastructureSettingsRsModelRegionObject, 0, len(ans.RegionIpv6.Region))
+		for var23Index := range ans.RegionIpv6.Region {
+			var23 := ans.RegionIpv6.Region[var23Index]
+			var var24 mobileAgentInfrastructureSettingsRsModelRegionObject
+			var24.Locations = EncodeStringSlice(var23.Locations)
+			var24.Name = types.StringValue(var23.Name)
+			var22 = append(var22, var24)
+		}
+	}
+	var21.Region = var22
+	var var25 *mobileAgentInfrastructureSettingsRsModelUdpQueriesObject
+	if ans.UdpQueries != nil {
+		var25 = &mobileAgentInfrastructureSettingsRsModelUdpQueriesObject{}
+		var var26 *mobileAgentInfrastructureSettingsRsModelRetriesObject
+		if ans.UdpQueries.Retries != nil {
+			var26 = &mobileAgentInfrastructureSettingsRsModelRetriesObject{}
+			var26.Attempts = types.Int64Value(ans.UdpQueries.Retries.Attempts)
+			var26.Interval = types.Int64Value(ans.UdpQueries.Retries.Interval)
+		}
+		var25.Retries = var26
+	}
+	state.DnsServers = var0
+	state.EnableWins = var10
+	state.IpPools = var15
+	state.Ipv6 = types.BoolValue(ans.Ipv6)
+	state.Name = types.StringValue(ans.Name)
+	state.PortalHostname = var18
+	state.RegionIpv6 = var21
+	state.UdpQueries = var25
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update resource.
+func (r *mobileAgentInfrastructureSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state mobileAgentInfrastructureSettingsRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_mobile_agent_infrastructure_settings", "update", plan.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource update", map[string]any{
+		"terraform_provider_function": "Update",
+		"resource_name":               "sase_mobile_agent_infrastructure_settings",
+		"folder":                      state.Folder.ValueString(),
+		"name":                        state.Name.ValueString(),
+	})
+
+	// Prepare to update the config.
+	svc := sefSZSA.NewClient(r.client)
+	input := sefSZSA.UpdateInput{
+		Folder: state.Folder.ValueString(),
+	}
+	var var0 jNcXhRe.Config
+	var var1 []jNcXhRe.DnsServersObject
+	if len(plan.DnsServers) != 0 {
+		var1 = make([]jNcXhRe.DnsServersObject, 0, len(plan.DnsServers))
+		for var2Index := range plan.DnsServers {
+			var2 := plan.DnsServers[var2Index]
+			var var3 jNcXhRe.DnsServersObject
+			var3.DnsSuffix = DecodeStringSlice(var2.DnsSuffix)
+			var var4 []jNcXhRe.InternalDnsMatchObject
+			if len(var2.InternalDnsMatch) != 0 {
+				var4 = make([]jNcXhRe.InternalDnsMatchObject, 0, len(var2.InternalDnsMatch))
+				for var5Index := range var2.InternalDnsMatch {
+					var5 := var2.InternalDnsMatch[var5Index]
+					var var6 jNcXhRe.InternalDnsMatchObject
+					var6.DomainList = DecodeStringSlice(var5.DomainList)
+					var6.Name = var5.Name.ValueString()
+					var var7 *jNcXhRe.PrimaryObject
+					if var5.Primary != nil {
+						var7 = &jNcXhRe.PrimaryObject{}
+						if var5.Primary.DnsServer.ValueBool() {
+							var7.DnsServer = struct{}{}
+						}
+						if var5.Primary.UseCloudDefault.ValueBool() {
+							var7.UseCloudDefault = struct{}{}
+						}
+					}
+					var6.Primary = var7
+					var var8 *jNcXhRe.SecondaryObject
+					if var5.Secondary != nil {
+						var8 = &jNcXhRe.SecondaryObject{}
+						if var5.Secondary.DnsServer.ValueBool() {
+							var8.DnsServer = struct{}{}
+						}
+						if var5.Secondary.UseCloudDefault.ValueBool() {
+							var8.UseCloudDefault = struct{}{}
+						}
+					}
+					var6.Secondary = var8
+					var4 = append(var4, var6)
+				}
+			}
+			var3.InternalDnsMatch = var4
+			var3.Name = var2.Name.ValueString()
+			var var9 *jNcXhRe.PrimaryPublicDnsObject
+			if var2.PrimaryPublicDns != nil {
+				var9 = &jNcXhRe.PrimaryPublicDnsObject{}
+				var9.DnsServer = var2.PrimaryPublicDns.DnsServer.ValueString()
+			}
+			var3.PrimaryPublicDns = var9
+			var var10 *jNcXhRe.SecondaryPublicDnsObject
+			if var2.SecondaryPublicDns != nil {
+				var10 = &jNcXhRe.SecondaryPublicDnsObject{}
+				var10.DnsServer = var2.SecondaryPublicDns.DnsServer.ValueString()
+			}
+			var3.SecondaryPublicDns = var10
+			var1 = append(var1, var3)
+		}
+	}
+	var0.DnsServers = var1
+	var var11 *jNcXhRe.EnableWinsObject
+	if plan.EnableWins != nil {
+		var11 = &jNcXhRe.EnableWinsObject{}
+		if plan.EnableWins.No.ValueBool() {
+			var11.No = struct{}{}
+		}
+		var var12 *jNcXhRe.YesObject
+		if plan.EnableWins.Yes != nil {
+			var12 = &jNcXhRe.YesObject{}
+			var var13 []jNcXhRe.WinsServersObject
+			if len(plan.EnableWins.Yes.WinsServers) != 0 {
+				var13 = make([]jNcXhRe.WinsServersObject, 0, len(plan.EnableWins.Yes.WinsServers))
+				for var14Index := range plan.EnableWins.Yes.WinsServers {
+					var14 := plan.EnableWins.Yes.WinsServers[var14Index]
+					var var15 jNcXhRe.WinsServersObject
+					var15.Name = var14.Name.ValueString()
+					var15.Primary = var14.Primary.ValueString()
+					var15.Secondary = var14.Secondary.ValueString()
+					var13 = append(var13, var15)
+				}
+			}
+			var12.WinsServers = var13
+		}
+		var11.Yes = var12
+	}
+	var0.EnableWins = var11
+	var var16 []jNcXhRe.IpPoolsObject
+	if len(plan.IpPools) != 0 {
+		var16 = make([]jNcXhRe.IpPoolsObject, 0, len(plan.IpPools))
+		for var17Index := range plan.IpPools {
+			var17 := plan.IpPools[var17Index]
+			var var18 jNcXhRe.IpPoolsObject
+			var18.IpPool = DecodeStringSlice(var17.IpPool)
+			var18.Name = var17.Name.ValueString()
+			var16 = append(var16, var18)
+		}
+	}
+	var0.IpPools = var16
+	var0.Ipv6 = plan.Ipv6.ValueBool()
+	var0.Name = plan.Name.ValueString()
+	var var19 jNcXhRe.PortalHostnameObject
+	var var20 *jNcXhRe.CustomDomainObject
+	if plan.PortalHostname.CustomDomain != nil {
+		var20 = &jNcXhRe.CustomDomainObject{}
+		var20.Cname = plan.PortalHostname.CustomDomain.Cname.ValueString()
+		var20.Hostname = plan.PortalHostname.CustomDomain.Hostname.ValueString()
+		var20.SslTlsServiceProfile = plan.PortalHostname.CustomDomain.SslTlsServiceProfile.ValueString()
+	}
+	var19.CustomDomain = var20
+	var var21 *jNcXhRe.DefaultDomainObject
+	if plan.PortalHostname.DefaultDomain != nil {
+		var21 = &jNcXhRe.DefaultDomainObject{}
+		var21.Hostname = plan.PortalHostname.DefaultDomain.Hostname.ValueString()
+	}
+	var19.DefaultDomain = var21
+	var0.PortalHostname = var19
+	var var22 jNcXhRe.RegionIpv6Object
+	var var23 []jNcXhRe.RegionObject
+	if len(plan.RegionIpv6.Region) != 0 {
+		var23 = make([]jNcXhRe.RegionObject, 0, len(plan.RegionIpv6.Region))
+		for var24Index := range plan.RegionIpv6.Region {
+			var24 := plan.RegionIpv6.Region[var24Index]
+			var var25 jNcXhRe.RegionObject
+			var25.Locations = DecodeStringSlice(var24.Locations)
+			var25.Name = var24.Name.ValueString()
+			var23 = append(var23, var25)
+		}
+	}
+	var22.Region = var23
+	var0.RegionIpv6 = var22
+	var var26 *jNcXhRe.UdpQueriesObject
+	if plan.UdpQueries != nil {
+		var26 = &jNcXhRe.UdpQueriesObject{}
+		var var27 *jNcXhRe.RetriesObject
+		if plan.UdpQueries.Retries != nil {
+			var27 = &jNcXhRe.RetriesObject{}
+			var27.Attempts = plan.UdpQueries.Retries.Attempts.ValueInt64()
+			var27.Interval = plan.UdpQueries.Retries.Interval.ValueInt64()
+		}
+		var26.Retries = var27
+	}
+	var0.UdpQueries = var26
+	input.Config = var0
+
+	// Perform the operation.
+	ans, err := svc.Update(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
+	var var28 []mobileAgentInfrastructureSettingsRsModelDnsServersObject
+	if len(ans.DnsServers) != 0 {
+		var28 = make([]mobileAgentInfrastructureSettingsRsModelDnsServersObject, 0, len(ans.DnsServers))
+		for var29Index := range ans.DnsServers {
+			var29 := ans.DnsServers[var29Index]
+			var var30 mobileAgentInfrastructureSettingsRsModelDnsServersObject
+			var var31 []mobileAgentInfrastructureSettingsRsModelInternalDnsMatchObject
+			if len(var29.InternalDnsMatch) != 0 {
+				var31 = make([]mobileAgentInfrastructureSettingsRsModelInternalDnsMatchObject, 0, len(var29.InternalDnsMatch))
+				for var32Index := range var29.InternalDnsMatch {
+					var32 := var29.InternalDnsMatch[var32Index]
+					var var33 mobileAgentInfrastructureSettingsRsModelInternalDnsMatchObject
+					var var34 *mobileAgentInfrastructureSettingsRsModelPrimaryObject
+					if var32.Primary != nil {
+						var34 = &mobileAgentInfrastructureSettingsRsModelPrimaryObject{}
+						if var32.Primary.DnsServer != nil {
+							var34.DnsServer = types.BoolValue(true)
+						}
+						if var32.Primary.UseCloudDefault != nil {
+							var34.UseCloudDefault = types.BoolValue(true)
+						}
+					}
+					var var35 *mobileAgentInfrastructureSettingsRsModelSecondaryObject
+					if var32.Secondary != nil {
+						var35 = &mobileAgentInfrastructureSettingsRsModelSecondaryObject{}
+						if var32.Secondary.DnsServer != nil {
+							var35.DnsServer = types.BoolValue(true)
+						}
+						if var32.Secondary.UseCloudDefault != nil {
+							var35.UseCloudDefault = types.BoolValue(true)
+						}
+					}
+					var33.DomainList = EncodeStringSlice(var32.DomainList)
+					var33.Name = types.StringValue(var32.Name)
+					var33.Primary = var34
+					var33.Secondary = var35
+					var31 = append(var31, var33)
+				}
+			}
+			var var36 *mobileAgentInfrastructureSettingsRsModelPrimaryPublicDnsObject
+			if var29.PrimaryPublicDns != nil {
+				var36 = &mobileAgentInfrastructureSettingsRsModelPrimaryPublicDnsObject{}
+				var36.DnsServer = types.StringValue(var29.PrimaryPublicDns.DnsServer)
+			}
+			var var37 *mobileAgentInfrastructureSettingsRsModelSecondaryPublicDnsObject
+			if var29.SecondaryPublicDns != nil {
+				var37 = &mobileAgentInfrastructureSettingsRsModelSecondaryPublicDnsObject{}
+				var37.DnsServer = types.StringValue(var29.SecondaryPublicDns.DnsServer)
+			}
+			var30.DnsSuffix = EncodeStringSlice(var29.DnsSuffix)
+			var30.InternalDnsMatch = var31
+			var30.Name = types.StringValue(var29.Name)
+			var30.PrimaryPublicDns = var36
+			var30.SecondaryPublicDns = var37
+			var28 = append(var28, var30)
+		}
+	}
+	var var38 *mobileAgentInfrastructureSettingsRsModelEnableWinsObject
+	if ans.EnableWins != nil {
+		var38 = &mobileAgentInfrastructureSettingsRsModelEnableWinsObject{}
+		var var39 *mobileAgentInfrastructureSettingsRsModelYesObject
+		if ans.EnableWins.Yes != nil {
+			var39 = &mobileAgentInfrastructureSettingsRsModelYesObject{}
+			var var40 []mobileAgentInfrastructureSettingsRsModelWinsServersObject
+			if len(ans.EnableWins.Yes.WinsServers) != 0 {
+				var40 = make([]mobileAgentInfrastructureSettingsRsModelWinsServersObject, 0, len(ans.EnableWins.Yes.WinsServers))
+				for var41Index := range ans.EnableWins.Yes.WinsServers {
+					var41 := ans.EnableWins.Yes.WinsServers[var41Index]
+					var var42 mobileAgentInfrastructureSettingsRsModelWinsServersObject
+					var42.Name = types.StringValue(var41.Name)
+					var42.Primary = types.StringValue(var41.Primary)
+					var42.Secondary = types.StringValue(var41.Secondary)
+					var40 = append(var40, var42)
+				}
+			}
+			var39.WinsServers = var40
+		}
+		if ans.EnableWins.No != nil {
+			var38.No = types.BoolValue(true)
+		}
+		var38.Yes = var39
+	}
+	var var43 []mobileAgentInfrastructureSettingsRsModelIpPoolsObject
+	if len(ans.IpPools) != 0 {
+		var43 = make([]mobileAgentInfrastructureSettingsRsModelIpPoolsObject, 0, len(ans.IpPools))
+		for var44Index := range ans.IpPools {
+			var44 := ans.IpPools[var44Index]
+			var var45 mobileAgentInfrastructureSettingsRsModelIpPoolsObject
+			var45.IpPool = EncodeStringSlice(var44.IpPool)
+			var45.Name = types.StringValue(var44.Name)
+			var43 = append(var43, var45)
+		}
+	}
+	var var46 mobileAgentInfrastructureSettingsRsModelPortalHostnameObject
+	var var47 *mobileAgentInfrastructureSettingsRsModelCustomDomainObject
+	if ans.PortalHostname.CustomDomain != nil {
+		var47 = &mobileAgentInfrastructureSettingsRsModelCustomDomainObject{}
+		var47.Cname = types.StringValue(ans.PortalHostname.CustomDomain.Cname)
+		var47.Hostname = types.StringValue(ans.PortalHostname.CustomDomain.Hostname)
+		var47.SslTlsServiceProfile = types.StringValue(ans.PortalHostname.CustomDomain.SslTlsServiceProfile)
+	}
+	var var48 *mobileAgentInfrastructureSettingsRsModelDefaultDomainObject
+	if ans.PortalHostname.DefaultDomain != nil {
+		var48 = &mobileAgentInfrastructureSettingsRsModelDefaultDomainObject{}
+		var48.Hostname = types.StringValue(ans.PortalHostname.DefaultDomain.Hostname)
+	}
+	var46.CustomDomain = var47
+	var46.DefaultDomain = var48
+	var var49 mobileAgentInfrastructureSettingsRsModelRegionIpv6Object
+	var var50 []mobileAgentInfrastructureSettingsRsModelRegionObject
+	if len(ans.RegionIpv6.Region) != 0 {
+		var50 = make([]mobileAgentInfrastructureSettingsRsModelRegionObject, 0, len(ans.RegionIpv6.Region))
+		for var51Index := range ans.RegionIpv6.Region {
+			var51 := ans.RegionIpv6.Region[var51Index]
+			var var52 mobileAgentInfrastructureSettingsRsModelRegionObject
+			var52.Locations = EncodeStringSlice(var51.Locations)
+			var52.Name = types.StringValue(var51.Name)
+			var50 = append(var50, var52)
+		}
+	}
+	var49.Region = var50
+	var var53 *mobileAgentInfrastructureSettingsRsModelUdpQueriesObject
+	if ans.UdpQueries != nil {
+		var53 = &mobileAgentInfrastructureSettingsRsModelUdpQueriesObject{}
+		var var54 *mobileAgentInfrastructureSettingsRsModelRetriesObject
+		if ans.UdpQueries.Retries != nil {
+			var54 = &mobileAgentInfrastructureSettingsRsModelRetriesObject{}
+			var54.Attempts = types.Int64Value(ans.UdpQueries.Retries.Attempts)
+			var54.Interval = types.Int64Value(ans.UdpQueries.Retries.Interval)
+		}
+		var53.Retries = var54
+	}
+	state.DnsServers = var28
+	state.EnableWins = var38
+	state.IpPools = var43
+	state.Ipv6 = types.BoolValue(ans.Ipv6)
+	state.Name = types.StringValue(ans.Name)
+	state.PortalHostname = var46
+	state.RegionIpv6 = var49
+	state.UdpQueries = var53
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete resource.
+func (r *mobileAgentInfrastructureSettingsResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Basic logging.
+	tflog.Info(ctx, "performing resource delete", map[string]any{
+		"terraform_provider_function": "Delete",
+		"resource_name":               "sase_mobile_agent_infrastructure_settings",
+	})
+
+	// The settings can't be deleted, so leave them as they are and only
+	// remove them from the state.
+	resp.Diagnostics.AddWarning(
+		"Settings Left In Place",
+		"The mobile agent infrastructure settings can't be removed, so they were only removed from the Terraform state.",
+	)
+}
+
+// ImportState imports the settings.  The import ID is "folder:name".
+func (r *mobileAgentInfrastructureSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
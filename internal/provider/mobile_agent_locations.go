@@ -2,14 +2,23 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/paloaltonetworks/sase-go"
+	kLwPzXb "github.com/paloaltonetworks/sase-go/netsec/schema/mobile/agent/locations"
 	pVYGFzR "github.com/paloaltonetworks/sase-go/netsec/service/v1/mobileagent/locations"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rsschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -196,3 +205,391 @@ func (d *mobileAgentLocationsListDataSource) Read(ctx context.Context, req datas
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// Resource.
+var (
+	_ resource.Resource                = &mobileAgentLocationsResource{}
+	_ resource.ResourceWithConfigure   = &mobileAgentLocationsResource{}
+	_ resource.ResourceWithImportState = &mobileAgentLocationsResource{}
+)
+
+func NewMobileAgentLocationsResource() resource.Resource {
+	return &mobileAgentLocationsResource{}
+}
+
+type mobileAgentLocationsResource struct {
+	client *sase.Client
+}
+
+type mobileAgentLocationsRsModel struct {
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
+
+	// Input.
+	Folder types.String `tfsdk:"folder"`
+
+	// Request body input.
+	// Ref: #/components/schemas/mobile-agent-locations
+	Locations []types.String `tfsdk:"locations"`
+	Region    types.String   `tfsdk:"region"`
+}
+
+// Metadata returns the data source type name.
+func (r *mobileAgentLocationsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mobile_agent_locations"
+}
+
+// Schema defines the schema for this listing data source.
+func (r *mobileAgentLocationsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rsschema.Schema{
+		Description: "Manages the GlobalProtect mobile agent locations of one region. The locations of other regions are left as they are.",
+
+		Attributes: map[string]rsschema.Attribute{
+			"id": rsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Input.
+			"folder": rsschema.StringAttribute{
+				Description:         "The folder of the entry. Value must be one of: `\"Mobile Users\"`.",
+				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Mobile Users\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Mobile Users"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"locations": rsschema.ListAttribute{
+				Description:         "The locations to deploy to in the region. List must contain at least 1 element. Each value must be unique.",
+				MarkdownDescription: "The locations to deploy to in the region. List must contain at least 1 element. Each value must be unique.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"region": rsschema.StringAttribute{
+				Description:         "The region name, such as `\"americas\"` or `\"europe\"`.",
+				MarkdownDescription: "The region name, such as `\"americas\"` or `\"europe\"`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_mobile_agent_locations"),
+		},
+	}
+}
+
+// Configure prepares the struct.
+func (r *mobileAgentLocationsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*sase.Client)
+}
+
+// Create resource
+func (r *mobileAgentLocationsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state mobileAgentLocationsRsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_mobile_agent_locations", "create", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource create", map[string]any{
+		"terraform_provider_function": "Create",
+		"resource_name":               "sase_mobile_agent_locations",
+		"folder":                      state.Folder.ValueString(),
+		"region":                      state.Region.ValueString(),
+	})
+
+	// Get the current regions.
+	defer lockMobileAgentFolder(state.Folder.ValueString())()
+	svc := pVYGFzR.NewClient(r.client)
+	regions, err := mobileAgentRegions(ctx, svc, state.Folder.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
+		return
+	}
+	for _, x := range regions {
+		if x.Name == state.Region.ValueString() {
+			resp.Diagnostics.AddError("Error in create", fmt.Sprintf("Region %q already has locations configured, import it instead.", x.Name))
+			return
+		}
+	}
+
+	// Prepare to create the config.
+	input := pVYGFzR.UpdateInput{
+		Folder: state.Folder.ValueString(),
+	}
+	input.Config.Region = setMobileAgentRegion(regions, state.Region.ValueString(), DecodeStringSlice(state.Locations))
+
+	// Perform the operation.
+	ans, err := svc.Update(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(input.Folder)
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(state.Region.ValueString())
+	state.Id = types.StringValue(idBuilder.String())
+	for _, x := range ans.Region {
+		if x.Name == state.Region.ValueString() {
+			state.Locations = EncodeStringSlice(x.Locations)
+		}
+	}
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read resource.
+func (r *mobileAgentLocationsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var idType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &idType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := idType.ValueString()
+	tokens := strings.Split(id, IdSeparator)
+	if len(tokens) != 2 {
+		resp.Diagnostics.AddError("Error in resource ID format", "Expected 2 tokens")
+		return
+	}
+
+	var state mobileAgentLocationsRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_mobile_agent_locations", "read", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource read", map[string]any{
+		"terraform_provider_function": "Read",
+		"resource_name":               "sase_mobile_agent_locations",
+		"locMap":                      map[string]int{"Folder": 0, "Region": 1},
+		"tokens":                      tokens,
+	})
+
+	// Perform the operation.
+	svc := pVYGFzR.NewClient(r.client)
+	regions, err := mobileAgentRegions(ctx, svc, tokens[0])
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	for _, x := range regions {
+		if x.Name == tokens[1] {
+			state.Id = idType
+			state.Folder = types.StringValue(tokens[0])
+			state.Region = types.StringValue(x.Name)
+			state.Locations = EncodeStringSlice(x.Locations)
+
+			// Done.
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+
+	// The region has no locations anymore.
+	resp.State.RemoveResource(ctx)
+}
+
+// Update resource.
+func (r *mobileAgentLocationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state mobileAgentLocationsRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_mobile_agent_locations", "update", plan.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource update", map[string]any{
+		"terraform_provider_function": "Update",
+		"resource_name":               "sase_mobile_agent_locations",
+		"folder":                      state.Folder.ValueString(),
+		"region":                      state.Region.ValueString(),
+	})
+
+	// Get the current regions.
+	defer lockMobileAgentFolder(state.Folder.ValueString())()
+	svc := pVYGFzR.NewClient(r.client)
+	regions, err := mobileAgentRegions(ctx, svc, state.Folder.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
+		return
+	}
+
+	// Prepare to update the config.
+	input := pVYGFzR.UpdateInput{
+		Folder: state.Folder.ValueString(),
+	}
+	input.Config.Region = setMobileAgentRegion(regions, state.Region.ValueString(), DecodeStringSlice(plan.Locations))
+
+	// Perform the operation.
+	ans, err := svc.Update(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
+	for _, x := range ans.Region {
+		if x.Name == state.Region.ValueString() {
+			state.Locations = EncodeStringSlice(x.Locations)
+		}
+	}
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete resource.
+func (r *mobileAgentLocationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var idType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &idType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := idType.ValueString()
+	tokens := strings.Split(id, IdSeparator)
+	if len(tokens) != 2 {
+		resp.Diagnostics.AddError("Error in resource ID format", "Expected 2 tokens")
+		return
+	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_mobile_agent_locations", "delete", timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource delete", map[string]any{
+		"terraform_provider_function": "Delete",
+		"resource_name":               "sase_mobile_agent_locations",
+		"locMap":                      map[string]int{"Folder": 0, "Region": 1},
+		"tokens":                      tokens,
+	})
+
+	// Get the current regions.
+	defer lockMobileAgentFolder(tokens[0])()
+	svc := pVYGFzR.NewClient(r.client)
+	regions, err := mobileAgentRegions(ctx, svc, tokens[0])
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
+		return
+	}
+
+	// Prepare to remove the region, keeping all others.
+	input := pVYGFzR.UpdateInput{
+		Folder: tokens[0],
+	}
+	input.Config.Region = setMobileAgentRegion(regions, tokens[1], nil)
+
+	// Perform the operation.
+	if _, err := svc.Update(ctx, input); err != nil {
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
+	}
+}
+
+// ImportState imports the locations.  The import ID is "folder:region".
+func (r *mobileAgentLocationsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// The regions of a folder are read and written back as a whole, so changes
+// to different regions of the same folder must not run in parallel.
+var mobileAgentFolderLocks = struct {
+	sync.Mutex
+	folders map[string]*sync.Mutex
+}{folders: make(map[string]*sync.Mutex)}
+
+// lockMobileAgentFolder locks the regions of the folder, returning the func
+// that unlocks them.
+func lockMobileAgentFolder(folder string) func() {
+	mobileAgentFolderLocks.Lock()
+	mu, ok := mobileAgentFolderLocks.folders[folder]
+	if !ok {
+		mu = &sync.Mutex{}
+		mobileAgentFolderLocks.folders[folder] = mu
+	}
+	mobileAgentFolderLocks.Unlock()
+
+	mu.Lock()
+	return mu.Unlock
+}
+
+// mobileAgentRegions returns the regions that currently have locations.
+func mobileAgentRegions(ctx context.Context, svc *pVYGFzR.Client, folder string) ([]kLwPzXb.RegionObject, error) {
+	list, err := svc.List(ctx, pVYGFzR.ListInput{
+		Folder: folder,
+	})
+	if err != nil || len(list.Data) == 0 {
+		return nil, err
+	}
+
+	return list.Data[0].Region, nil
+}
+
+// setMobileAgentRegion returns the regions with the locations of the named
+// region replaced, or the region removed if there are no locations.
+func setMobileAgentRegion(regions []kLwPzXb.RegionObject, name string, locations []string) []kLwPzXb.RegionObject {
+	ans := make([]kLwPzXb.RegionObject, 0, len(regions)+1)
+	found := false
+	for _, x := range regions {
+		if x.Name != name {
+			ans = append(ans, x)
+		} else if len(locations) != 0 {
+			x.Locations = locations
+			ans = append(ans, x)
+			found = true
+		}
+	}
+	if !found && len(locations) != 0 {
+		ans = append(ans, kLwPzXb.RegionObject{
+			Name:      name,
+			Locations: locations,
+		})
+	}
+
+	return ans
+}
@@ -10,6 +10,8 @@ import (
 	evToKLE "github.com/paloaltonetworks/sase-go/netsec/schema/objects/addresses"
 	zLXjrfn "github.com/paloaltonetworks/sase-go/netsec/service/v1/addresses"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -41,10 +43,13 @@ type objectsAddressesListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Name   types.String `tfsdk:"name"`
-	Folder types.String `tfsdk:"folder"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Name     types.String `tfsdk:"name"`
+	Folder   types.String `tfsdk:"folder"`
 
 	// Output.
 	Data []objectsAddressesListDsModelConfig `tfsdk:"data"`
@@ -95,6 +100,27 @@ func (d *objectsAddressesListDataSource) Schema(_ context.Context, _ datasource.
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"name": dsschema.StringAttribute{
 				Description:         "The name of the entry.",
 				MarkdownDescription: "The name of the entry.",
@@ -218,12 +244,32 @@ func (d *objectsAddressesListDataSource) Read(ctx context.Context, req datasourc
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -448,9 +494,10 @@ func (d *objectsAddressesDataSource) Read(ctx context.Context, req datasource.Re
 
 // Resource.
 var (
-	_ resource.Resource                = &objectsAddressesResource{}
-	_ resource.ResourceWithConfigure   = &objectsAddressesResource{}
-	_ resource.ResourceWithImportState = &objectsAddressesResource{}
+	_ resource.Resource                     = &objectsAddressesResource{}
+	_ resource.ResourceWithConfigure        = &objectsAddressesResource{}
+	_ resource.ResourceWithConfigValidators = &objectsAddressesResource{}
+	_ resource.ResourceWithImportState      = &objectsAddressesResource{}
 )
 
 func NewObjectsAddressesResource() resource.Resource {
@@ -462,7 +509,8 @@ type objectsAddressesResource struct {
 }
 
 type objectsAddressesRsModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
 
 	// Input.
 	Folder types.String `tfsdk:"folder"`
@@ -508,6 +556,9 @@ func (r *objectsAddressesResource) Schema(_ context.Context, _ resource.SchemaRe
 				Validators: []validator.String{
 					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 
 			"description": rsschema.StringAttribute{
@@ -523,14 +574,15 @@ func (r *objectsAddressesResource) Schema(_ context.Context, _ resource.SchemaRe
 				},
 			},
 			"fqdn": rsschema.StringAttribute{
-				Description:         "The `fqdn` parameter. String length must be between 1 and 255. Conflicts with: `ip_netmask`, `ip_range`, `ip_wildcard`.",
-				MarkdownDescription: "The `fqdn` parameter. String length must be between 1 and 255. Conflicts with: `ip_netmask`, `ip_range`, `ip_wildcard`.",
+				Description:         "The `fqdn` parameter. String length must be between 1 and 255. Conflicts with: `ip_netmask`, `ip_range`, `ip_wildcard`. Value must be a fully qualified domain name.",
+				MarkdownDescription: "The `fqdn` parameter. String length must be between 1 and 255. Conflicts with: `ip_netmask`, `ip_range`, `ip_wildcard`. Value must be a fully qualified domain name.",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					DefaultString(""),
 				},
 				Validators: []validator.String{
+					IsFqdn(),
 					stringvalidator.LengthBetween(1, 255),
 					stringvalidator.ConflictsWith(
 						path.MatchRelative().AtParent().AtName("ip_netmask"),
@@ -548,14 +600,15 @@ func (r *objectsAddressesResource) Schema(_ context.Context, _ resource.SchemaRe
 				},
 			},
 			"ip_netmask": rsschema.StringAttribute{
-				Description:         "The `ip_netmask` parameter. Conflicts with: `fqdn`, `ip_range`, `ip_wildcard`.",
-				MarkdownDescription: "The `ip_netmask` parameter. Conflicts with: `fqdn`, `ip_range`, `ip_wildcard`.",
+				Description:         "The `ip_netmask` parameter. Conflicts with: `fqdn`, `ip_range`, `ip_wildcard`. Value must be an IPv4 or IPv6 address, optionally with a prefix length.",
+				MarkdownDescription: "The `ip_netmask` parameter. Conflicts with: `fqdn`, `ip_range`, `ip_wildcard`. Value must be an IPv4 or IPv6 address, optionally with a prefix length.",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					DefaultString(""),
 				},
 				Validators: []validator.String{
+					IsIpAddressOrPrefix(),
 					stringvalidator.ConflictsWith(
 						path.MatchRelative().AtParent().AtName("fqdn"),
 						path.MatchRelative().AtParent().AtName("ip_range"),
@@ -564,14 +617,15 @@ func (r *objectsAddressesResource) Schema(_ context.Context, _ resource.SchemaRe
 				},
 			},
 			"ip_range": rsschema.StringAttribute{
-				Description:         "The `ip_range` parameter. Conflicts with: `fqdn`, `ip_netmask`, `ip_wildcard`.",
-				MarkdownDescription: "The `ip_range` parameter. Conflicts with: `fqdn`, `ip_netmask`, `ip_wildcard`.",
+				Description:         "The `ip_range` parameter. Conflicts with: `fqdn`, `ip_netmask`, `ip_wildcard`. Value must be a range of IPv4 or IPv6 addresses, such as \"10.0.0.1-10.0.0.20\".",
+				MarkdownDescription: "The `ip_range` parameter. Conflicts with: `fqdn`, `ip_netmask`, `ip_wildcard`. Value must be a range of IPv4 or IPv6 addresses, such as \"10.0.0.1-10.0.0.20\".",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					DefaultString(""),
 				},
 				Validators: []validator.String{
+					IsIpRange(),
 					stringvalidator.ConflictsWith(
 						path.MatchRelative().AtParent().AtName("fqdn"),
 						path.MatchRelative().AtParent().AtName("ip_netmask"),
@@ -580,14 +634,15 @@ func (r *objectsAddressesResource) Schema(_ context.Context, _ resource.SchemaRe
 				},
 			},
 			"ip_wildcard": rsschema.StringAttribute{
-				Description:         "The `ip_wildcard` parameter. Conflicts with: `fqdn`, `ip_netmask`, `ip_range`.",
-				MarkdownDescription: "The `ip_wildcard` parameter. Conflicts with: `fqdn`, `ip_netmask`, `ip_range`.",
+				Description:         "The `ip_wildcard` parameter. Conflicts with: `fqdn`, `ip_netmask`, `ip_range`. Value must be an IPv4 address and wildcard mask, such as \"10.0.0.0/0.0.255.255\".",
+				MarkdownDescription: "The `ip_wildcard` parameter. Conflicts with: `fqdn`, `ip_netmask`, `ip_range`. Value must be an IPv4 address and wildcard mask, such as \"10.0.0.0/0.0.255.255\".",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					DefaultString(""),
 				},
 				Validators: []validator.String{
+					IsIpWildcard(),
 					stringvalidator.ConflictsWith(
 						path.MatchRelative().AtParent().AtName("fqdn"),
 						path.MatchRelative().AtParent().AtName("ip_netmask"),
@@ -618,6 +673,21 @@ func (r *objectsAddressesResource) Schema(_ context.Context, _ resource.SchemaRe
 				},
 			},
 		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_objects_addresses"),
+		},
+	}
+}
+
+// ConfigValidators returns the checks for mutually exclusive attributes.
+func (r *objectsAddressesResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("fqdn"),
+			path.MatchRoot("ip_netmask"),
+			path.MatchRoot("ip_range"),
+			path.MatchRoot("ip_wildcard"),
+		),
 	}
 }
 
@@ -638,6 +708,9 @@ func (r *objectsAddressesResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_objects_addresses", "create", state.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource create", map[string]any{
 		"terraform_provider_function": "Create",
@@ -663,7 +736,7 @@ func (r *objectsAddressesResource) Create(ctx context.Context, req resource.Crea
 	// Perform the operation.
 	ans, err := svc.Create(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in create", err.Error())
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
 		return
 	}
 
@@ -702,6 +775,13 @@ func (r *objectsAddressesResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	var state objectsAddressesRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_objects_addresses", "read", state.Timeouts)
+	defer cancel()
 
 	// Basic logging.
 	tflog.Info(ctx, "performing resource read", map[string]any{
@@ -724,7 +804,7 @@ func (r *objectsAddressesResource) Read(ctx context.Context, req resource.ReadRe
 		if IsObjectNotFound(err) {
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error reading config", err.Error())
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
 		}
 		return
 	}
@@ -759,6 +839,9 @@ func (r *objectsAddressesResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_objects_addresses", "update", plan.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource update", map[string]any{
 		"terraform_provider_function": "Update",
@@ -784,11 +867,12 @@ func (r *objectsAddressesResource) Update(ctx context.Context, req resource.Upda
 	// Perform the operation.
 	ans, err := svc.Update(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in update", err.Error())
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
 		return
 	}
 
 	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
 	state.Description = types.StringValue(ans.Description)
 	state.Fqdn = types.StringValue(ans.Fqdn)
 	state.ObjectId = types.StringValue(ans.ObjectId)
@@ -810,6 +894,16 @@ func (r *objectsAddressesResource) Delete(ctx context.Context, req resource.Dele
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_objects_addresses", "delete", timeouts)
+	defer cancel()
+
 	id := idType.ValueString()
 	tokens := strings.Split(id, IdSeparator)
 	if len(tokens) != 2 {
@@ -832,10 +926,59 @@ func (r *objectsAddressesResource) Delete(ctx context.Context, req resource.Dele
 
 	// Perform the operation.
 	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
-		resp.Diagnostics.AddError("Error in delete", err.Error())
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
 	}
 }
 
+// ImportState imports by either the resource ID or by location params and name,
+// such as `folder=...,name=...`.
 func (r *objectsAddressesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	params, ok, err := ParseImportId(req.ID, "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_objects_addresses",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := zLXjrfn.NewClient(r.client)
+	input := zLXjrfn.ListInput{
+		Folder: params["folder"],
+		Name:   api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
 }
@@ -10,6 +10,7 @@ import (
 	lhPcfTR "github.com/paloaltonetworks/sase-go/netsec/schema/objects/application/filters"
 	jHKNPjP "github.com/paloaltonetworks/sase-go/netsec/service/v1/applicationfilters"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -41,10 +42,13 @@ type objectsApplicationFiltersListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Name   types.String `tfsdk:"name"`
-	Folder types.String `tfsdk:"folder"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Name     types.String `tfsdk:"name"`
+	Folder   types.String `tfsdk:"folder"`
 
 	// Output.
 	Data []objectsApplicationFiltersListDsModelConfig `tfsdk:"data"`
@@ -111,6 +115,27 @@ func (d *objectsApplicationFiltersListDataSource) Schema(_ context.Context, _ da
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"name": dsschema.StringAttribute{
 				Description:         "The name of the entry.",
 				MarkdownDescription: "The name of the entry.",
@@ -308,12 +333,32 @@ func (d *objectsApplicationFiltersListDataSource) Read(ctx context.Context, req
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -649,9 +694,10 @@ func (d *objectsApplicationFiltersDataSource) Read(ctx context.Context, req data
 
 // Resource.
 var (
-	_ resource.Resource                = &objectsApplicationFiltersResource{}
-	_ resource.ResourceWithConfigure   = &objectsApplicationFiltersResource{}
-	_ resource.ResourceWithImportState = &objectsApplicationFiltersResource{}
+	_ resource.Resource                     = &objectsApplicationFiltersResource{}
+	_ resource.ResourceWithConfigure        = &objectsApplicationFiltersResource{}
+	_ resource.ResourceWithConfigValidators = &objectsApplicationFiltersResource{}
+	_ resource.ResourceWithImportState      = &objectsApplicationFiltersResource{}
 )
 
 func NewObjectsApplicationFiltersResource() resource.Resource {
@@ -663,7 +709,8 @@ type objectsApplicationFiltersResource struct {
 }
 
 type objectsApplicationFiltersRsModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
 
 	// Input.
 	Folder types.String `tfsdk:"folder"`
@@ -725,6 +772,9 @@ func (r *objectsApplicationFiltersResource) Schema(_ context.Context, _ resource
 				Validators: []validator.String{
 					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 
 			"category": rsschema.ListAttribute{
@@ -898,6 +948,16 @@ func (r *objectsApplicationFiltersResource) Schema(_ context.Context, _ resource
 				},
 			},
 		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_objects_application_filters"),
+		},
+	}
+}
+
+// ConfigValidators returns the checks for mutually exclusive attributes.
+func (r *objectsApplicationFiltersResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		ExactlyOneOfNested(path.MatchRoot("tagging"), "no_tag", "tag"),
 	}
 }
 
@@ -918,6 +978,9 @@ func (r *objectsApplicationFiltersResource) Create(ctx context.Context, req reso
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_objects_application_filters", "create", state.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource create", map[string]any{
 		"terraform_provider_function": "Create",
@@ -961,7 +1024,7 @@ func (r *objectsApplicationFiltersResource) Create(ctx context.Context, req reso
 	// Perform the operation.
 	ans, err := svc.Create(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in create", err.Error())
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
 		return
 	}
 
@@ -1017,6 +1080,13 @@ func (r *objectsApplicationFiltersResource) Read(ctx context.Context, req resour
 	}
 
 	var state objectsApplicationFiltersRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_objects_application_filters", "read", state.Timeouts)
+	defer cancel()
 
 	// Basic logging.
 	tflog.Info(ctx, "performing resource read", map[string]any{
@@ -1038,7 +1108,7 @@ func (r *objectsApplicationFiltersResource) Read(ctx context.Context, req resour
 		if IsObjectNotFound(err) {
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error reading config", err.Error())
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
 		}
 		return
 	}
@@ -1090,6 +1160,9 @@ func (r *objectsApplicationFiltersResource) Update(ctx context.Context, req reso
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_objects_application_filters", "update", plan.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource update", map[string]any{
 		"terraform_provider_function": "Update",
@@ -1133,11 +1206,12 @@ func (r *objectsApplicationFiltersResource) Update(ctx context.Context, req reso
 	// Perform the operation.
 	ans, err := svc.Update(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in update", err.Error())
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
 		return
 	}
 
 	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
 	var var2 *objectsApplicationFiltersRsModelTaggingObject
 	if ans.Tagging != nil {
 		var2 = &objectsApplicationFiltersRsModelTaggingObject{}
@@ -1176,6 +1250,16 @@ func (r *objectsApplicationFiltersResource) Delete(ctx context.Context, req reso
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_objects_application_filters", "delete", timeouts)
+	defer cancel()
+
 	id := idType.ValueString()
 	tokens := strings.Split(id, IdSeparator)
 	if len(tokens) != 2 {
@@ -1198,10 +1282,78 @@ func (r *objectsApplicationFiltersResource) Delete(ctx context.Context, req reso
 
 	// Perform the operation.
 	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
-		resp.Diagnostics.AddError("Error in delete", err.Error())
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
 	}
 }
 
+// ImportState imports by either the resource ID or by location params and name,
+// such as `folder=...,name=...`.
 func (r *objectsApplicationFiltersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	params, ok, err := ParseImportId(req.ID, "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_objects_application_filters",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := jHKNPjP.NewClient(r.client)
+	input := jHKNPjP.ListInput{
+		Folder: params["folder"],
+		Name:   api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
+}
+
+// ApplicationFilterExists is a ReferenceLookup for application filters.
+func ApplicationFilterExists(ctx context.Context, client *sase.Client, folder, name string) (bool, error) {
+	svc := jHKNPjP.NewClient(client)
+	ans, err := svc.List(ctx, jHKNPjP.ListInput{
+		Folder: folder,
+		Name:   api.String(name),
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, x := range ans.Data {
+		if x.Name == name {
+			return true, nil
+		}
+	}
+
+	return false, nil
 }
@@ -7,11 +7,20 @@ import (
 
 	"github.com/paloaltonetworks/sase-go"
 	"github.com/paloaltonetworks/sase-go/api"
+	cXwTaRn "github.com/paloaltonetworks/sase-go/netsec/schema/objects/application/groups"
 	lmLGEJc "github.com/paloaltonetworks/sase-go/netsec/service/v1/applicationgroups"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rsschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -35,10 +44,13 @@ type objectsApplicationGroupsListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Name   types.String `tfsdk:"name"`
-	Folder types.String `tfsdk:"folder"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Name     types.String `tfsdk:"name"`
+	Folder   types.String `tfsdk:"folder"`
 
 	// Output.
 	Data []objectsApplicationGroupsListDsModelConfig `tfsdk:"data"`
@@ -83,6 +95,27 @@ func (d *objectsApplicationGroupsListDataSource) Schema(_ context.Context, _ dat
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"name": dsschema.StringAttribute{
 				Description:         "The name of the entry.",
 				MarkdownDescription: "The name of the entry.",
@@ -176,12 +209,32 @@ func (d *objectsApplicationGroupsListDataSource) Read(ctx context.Context, req d
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -355,3 +408,444 @@ func (d *objectsApplicationGroupsDataSource) Read(ctx context.Context, req datas
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// Resource.
+var (
+	_ resource.Resource                = &objectsApplicationGroupsResource{}
+	_ resource.ResourceWithConfigure   = &objectsApplicationGroupsResource{}
+	_ resource.ResourceWithImportState = &objectsApplicationGroupsResource{}
+	_ resource.ResourceWithModifyPlan  = &objectsApplicationGroupsResource{}
+)
+
+func NewObjectsApplicationGroupsResource() resource.Resource {
+	return &objectsApplicationGroupsResource{}
+}
+
+type objectsApplicationGroupsResource struct {
+	client *sase.Client
+}
+
+type objectsApplicationGroupsRsModel struct {
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
+
+	// Input.
+	Folder types.String `tfsdk:"folder"`
+
+	// Request body input.
+	// Ref: #/components/schemas/application-groups
+	Members  []types.String `tfsdk:"members"`
+	ObjectId types.String   `tfsdk:"object_id"`
+	Name     types.String   `tfsdk:"name"`
+}
+
+// Metadata returns the data source type name.
+func (r *objectsApplicationGroupsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objects_application_groups"
+}
+
+// Schema defines the schema for this listing data source.
+func (r *objectsApplicationGroupsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rsschema.Schema{
+		Description: "Retrieves config for a specific item.",
+
+		Attributes: map[string]rsschema.Attribute{
+			"id": rsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Input.
+			"folder": rsschema.StringAttribute{
+				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"members": rsschema.ListAttribute{
+				Description:         "The `members` parameter. Each member must be an application, application filter, or application group that exists in the folder or in a folder that it inherits from, such as `\"Shared\"`. This is checked at plan time, and a member that is not found is only a warning, as predefined applications can't be looked up. List must have at least 1 element.",
+				MarkdownDescription: "The `members` parameter. Each member must be an application, application filter, or application group that exists in the folder or in a folder that it inherits from, such as `\"Shared\"`. This is checked at plan time, and a member that is not found is only a warning, as predefined applications can't be looked up. List must have at least 1 element.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"object_id": rsschema.StringAttribute{
+				Description:         "The `object_id` parameter.",
+				MarkdownDescription: "The `object_id` parameter.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": rsschema.StringAttribute{
+				Description:         "The `name` parameter. String length must be at most 31.",
+				MarkdownDescription: "The `name` parameter. String length must be at most 31.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(31),
+				},
+			},
+		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_objects_application_groups"),
+		},
+	}
+}
+
+// Configure prepares the struct.
+func (r *objectsApplicationGroupsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*sase.Client)
+}
+
+// ModifyPlan checks that the members of the group exist, so that a bad member
+// is reported before anything is applied.  Predefined applications can't be
+// looked up, so a member that isn't found is a warning, not an error.
+func (r *objectsApplicationGroupsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var folder types.String
+	var members, prior types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("folder"), &folder)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("members"), &members)...)
+	if resp.Diagnostics.HasError() || folder.IsUnknown() || members.IsUnknown() {
+		return
+	}
+
+	// Skip the lookups if nothing that they depend on has changed.
+	if !req.State.Raw.IsNull() {
+		var priorFolder types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("folder"), &priorFolder)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("members"), &prior)...)
+		if resp.Diagnostics.HasError() || (priorFolder.Equal(folder) && prior.Equal(members)) {
+			return
+		}
+	}
+
+	var list []types.String
+	resp.Diagnostics.Append(members.ElementsAs(ctx, &list, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A member that isn't found may still be a predefined application, so
+	// that is only warned about.
+	for i, member := range list {
+		p := path.Root("members").AtListIndex(i)
+		var diags diag.Diagnostics
+		CheckReference(ctx, &diags, r.client, p, "application, application filter, or application group", folder.ValueString(), member, ApplicationGroupMemberExists)
+		for _, d := range diags {
+			if d.Severity() == diag.SeverityError && d.Summary() == "Invalid Reference" {
+				resp.Diagnostics.AddAttributeWarning(p, "Unknown Application Group Member", d.Detail()+" If it is a predefined application, this can be ignored.")
+				continue
+			}
+			resp.Diagnostics.Append(d)
+		}
+	}
+}
+
+// Create resource.
+func (r *objectsApplicationGroupsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state objectsApplicationGroupsRsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_objects_application_groups", "create", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource create", map[string]any{
+		"terraform_provider_function": "Create",
+		"resource_name":               "sase_objects_application_groups",
+		"folder":                      state.Folder.ValueString(),
+	})
+
+	// Prepare to create the config.
+	svc := lmLGEJc.NewClient(r.client)
+	input := lmLGEJc.CreateInput{
+		Folder: state.Folder.ValueString(),
+	}
+	var var0 cXwTaRn.Config
+	var0.Members = DecodeStringSlice(state.Members)
+	var0.Name = state.Name.ValueString()
+	input.Config = var0
+
+	// Perform the operation.
+	ans, err := svc.Create(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(input.Folder)
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(ans.ObjectId)
+	state.Id = types.StringValue(idBuilder.String())
+	state.Members = EncodeStringSlice(ans.Members)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.Name = types.StringValue(ans.Name)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read resource.
+func (r *objectsApplicationGroupsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var idType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &idType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := idType.ValueString()
+	tokens := strings.Split(id, IdSeparator)
+	if len(tokens) != 2 {
+		resp.Diagnostics.AddError("Error in resource ID format", "Expected 2 tokens")
+		return
+	}
+
+	var state objectsApplicationGroupsRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_objects_application_groups", "read", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource read", map[string]any{
+		"terraform_provider_function": "Read",
+		"resource_name":               "sase_objects_application_groups",
+		"locMap":                      map[string]int{"Folder": 0, "ObjectId": 1},
+		"tokens":                      tokens,
+	})
+
+	// Prepare to read the config.
+	svc := lmLGEJc.NewClient(r.client)
+	input := lmLGEJc.ReadInput{
+		ObjectId: tokens[1],
+		Folder:   tokens[0],
+	}
+
+	// Perform the operation.
+	ans, err := svc.Read(ctx, input)
+	if err != nil {
+		if IsObjectNotFound(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
+		}
+		return
+	}
+
+	// Store the answer to state.
+	state.Folder = types.StringValue(tokens[0])
+	state.Id = idType
+	state.Members = EncodeStringSlice(ans.Members)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.Name = types.StringValue(ans.Name)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update resource.
+func (r *objectsApplicationGroupsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state objectsApplicationGroupsRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_objects_application_groups", "update", plan.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource update", map[string]any{
+		"terraform_provider_function": "Update",
+		"resource_name":               "sase_objects_application_groups",
+		"object_id":                   state.ObjectId.ValueString(),
+	})
+
+	// Prepare to create the config.
+	svc := lmLGEJc.NewClient(r.client)
+	input := lmLGEJc.UpdateInput{
+		ObjectId: state.ObjectId.ValueString(),
+	}
+	var var0 cXwTaRn.Config
+	var0.Members = DecodeStringSlice(plan.Members)
+	var0.Name = plan.Name.ValueString()
+	input.Config = var0
+
+	// Perform the operation.
+	ans, err := svc.Update(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
+	state.Members = EncodeStringSlice(ans.Members)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.Name = types.StringValue(ans.Name)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete resource.
+func (r *objectsApplicationGroupsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var idType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &idType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_objects_application_groups", "delete", timeouts)
+	defer cancel()
+
+	id := idType.ValueString()
+	tokens := strings.Split(id, IdSeparator)
+	if len(tokens) != 2 {
+		resp.Diagnostics.AddError("Error in resource ID format", "Expected 2 tokens")
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource delete", map[string]any{
+		"terraform_provider_function": "Delete",
+		"resource_name":               "sase_objects_application_groups",
+		"locMap":                      map[string]int{"Folder": 0, "ObjectId": 1},
+		"tokens":                      tokens,
+	})
+
+	svc := lmLGEJc.NewClient(r.client)
+	input := lmLGEJc.DeleteInput{
+		ObjectId: tokens[1],
+	}
+
+	// Perform the operation.
+	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
+	}
+}
+
+// ImportState imports by either the resource ID or by location params and name,
+// such as `folder=...,name=...`.
+func (r *objectsApplicationGroupsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	params, ok, err := ParseImportId(req.ID, "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_objects_application_groups",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := lmLGEJc.NewClient(r.client)
+	input := lmLGEJc.ListInput{
+		Folder: params["folder"],
+		Name:   api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
+}
+
+// ApplicationGroupExists is a ReferenceLookup for application groups.
+func ApplicationGroupExists(ctx context.Context, client *sase.Client, folder, name string) (bool, error) {
+	svc := lmLGEJc.NewClient(client)
+	ans, err := svc.List(ctx, lmLGEJc.ListInput{
+		Folder: folder,
+		Name:   api.String(name),
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, x := range ans.Data {
+		if x.Name == name {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// ApplicationGroupMemberExists is a ReferenceLookup for anything that can be
+// a member of an application group: a custom application, an application
+// filter, or another application group.  Predefined applications are not
+// looked up, see ApplicationExists.
+func ApplicationGroupMemberExists(ctx context.Context, client *sase.Client, folder, name string) (bool, error) {
+	for _, lookup := range []ReferenceLookup{ApplicationExists, ApplicationFilterExists, ApplicationGroupExists} {
+		ok, err := lookup(ctx, client, folder, name)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+
+	return false, nil
+}
@@ -43,10 +43,13 @@ type objectsExternalDynamicListsListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Name   types.String `tfsdk:"name"`
-	Folder types.String `tfsdk:"folder"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Name     types.String `tfsdk:"name"`
+	Folder   types.String `tfsdk:"folder"`
 
 	// Output.
 	Data []objectsExternalDynamicListsListDsModelConfig `tfsdk:"data"`
@@ -186,6 +189,27 @@ func (d *objectsExternalDynamicListsListDataSource) Schema(_ context.Context, _
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"name": dsschema.StringAttribute{
 				Description:         "The name of the entry.",
 				MarkdownDescription: "The name of the entry.",
@@ -236,6 +260,7 @@ func (d *objectsExternalDynamicListsListDataSource) Schema(_ context.Context, _
 													Description:         "The `password` parameter.",
 													MarkdownDescription: "The `password` parameter.",
 													Computed:            true,
+													Sensitive:           true,
 												},
 												"username": dsschema.StringAttribute{
 													Description:         "The `username` parameter.",
@@ -349,6 +374,7 @@ func (d *objectsExternalDynamicListsListDataSource) Schema(_ context.Context, _
 													Description:         "The `password` parameter.",
 													MarkdownDescription: "The `password` parameter.",
 													Computed:            true,
+													Sensitive:           true,
 												},
 												"username": dsschema.StringAttribute{
 													Description:         "The `username` parameter.",
@@ -457,6 +483,7 @@ func (d *objectsExternalDynamicListsListDataSource) Schema(_ context.Context, _
 													Description:         "The `password` parameter.",
 													MarkdownDescription: "The `password` parameter.",
 													Computed:            true,
+													Sensitive:           true,
 												},
 												"username": dsschema.StringAttribute{
 													Description:         "The `username` parameter.",
@@ -565,6 +592,7 @@ func (d *objectsExternalDynamicListsListDataSource) Schema(_ context.Context, _
 													Description:         "The `password` parameter.",
 													MarkdownDescription: "The `password` parameter.",
 													Computed:            true,
+													Sensitive:           true,
 												},
 												"username": dsschema.StringAttribute{
 													Description:         "The `username` parameter.",
@@ -719,6 +747,7 @@ func (d *objectsExternalDynamicListsListDataSource) Schema(_ context.Context, _
 													Description:         "The `password` parameter.",
 													MarkdownDescription: "The `password` parameter.",
 													Computed:            true,
+													Sensitive:           true,
 												},
 												"username": dsschema.StringAttribute{
 													Description:         "The `username` parameter.",
@@ -871,12 +900,32 @@ func (d *objectsExternalDynamicListsListDataSource) Read(ctx context.Context, re
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -1341,6 +1390,7 @@ func (d *objectsExternalDynamicListsDataSource) Schema(_ context.Context, _ data
 										Description:         "The `password` parameter.",
 										MarkdownDescription: "The `password` parameter.",
 										Computed:            true,
+										Sensitive:           true,
 									},
 									"username": dsschema.StringAttribute{
 										Description:         "The `username` parameter.",
@@ -1454,6 +1504,7 @@ func (d *objectsExternalDynamicListsDataSource) Schema(_ context.Context, _ data
 										Description:         "The `password` parameter.",
 										MarkdownDescription: "The `password` parameter.",
 										Computed:            true,
+										Sensitive:           true,
 									},
 									"username": dsschema.StringAttribute{
 										Description:         "The `username` parameter.",
@@ -1562,6 +1613,7 @@ func (d *objectsExternalDynamicListsDataSource) Schema(_ context.Context, _ data
 										Description:         "The `password` parameter.",
 										MarkdownDescription: "The `password` parameter.",
 										Computed:            true,
+										Sensitive:           true,
 									},
 									"username": dsschema.StringAttribute{
 										Description:         "The `username` parameter.",
@@ -1670,6 +1722,7 @@ func (d *objectsExternalDynamicListsDataSource) Schema(_ context.Context, _ data
 										Description:         "The `password` parameter.",
 										MarkdownDescription: "The `password` parameter.",
 										Computed:            true,
+										Sensitive:           true,
 									},
 									"username": dsschema.StringAttribute{
 										Description:         "The `username` parameter.",
@@ -1824,6 +1877,7 @@ func (d *objectsExternalDynamicListsDataSource) Schema(_ context.Context, _ data
 										Description:         "The `password` parameter.",
 										MarkdownDescription: "The `password` parameter.",
 										Computed:            true,
+										Sensitive:           true,
 									},
 									"username": dsschema.StringAttribute{
 										Description:         "The `username` parameter.",
@@ -2216,9 +2270,10 @@ func (d *objectsExternalDynamicListsDataSource) Read(ctx context.Context, req da
 
 // Resource.
 var (
-	_ resource.Resource                = &objectsExternalDynamicListsResource{}
-	_ resource.ResourceWithConfigure   = &objectsExternalDynamicListsResource{}
-	_ resource.ResourceWithImportState = &objectsExternalDynamicListsResource{}
+	_ resource.Resource                     = &objectsExternalDynamicListsResource{}
+	_ resource.ResourceWithConfigure        = &objectsExternalDynamicListsResource{}
+	_ resource.ResourceWithConfigValidators = &objectsExternalDynamicListsResource{}
+	_ resource.ResourceWithImportState      = &objectsExternalDynamicListsResource{}
 )
 
 func NewObjectsExternalDynamicListsResource() resource.Resource {
@@ -2230,7 +2285,8 @@ type objectsExternalDynamicListsResource struct {
 }
 
 type objectsExternalDynamicListsRsModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
 
 	// Input.
 	Folder types.String `tfsdk:"folder"`
@@ -2365,6 +2421,9 @@ func (r *objectsExternalDynamicListsResource) Schema(_ context.Context, _ resour
 				Validators: []validator.String{
 					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 
 			"object_id": rsschema.StringAttribute{
@@ -2402,6 +2461,7 @@ func (r *objectsExternalDynamicListsResource) Schema(_ context.Context, _ resour
 										Description:         "The `password` parameter. String length must be at most 255.",
 										MarkdownDescription: "The `password` parameter. String length must be at most 255.",
 										Required:            true,
+										Sensitive:           true,
 										Validators: []validator.String{
 											stringvalidator.LengthAtMost(255),
 										},
@@ -2570,6 +2630,7 @@ func (r *objectsExternalDynamicListsResource) Schema(_ context.Context, _ resour
 										Description:         "The `password` parameter. String length must be at most 255.",
 										MarkdownDescription: "The `password` parameter. String length must be at most 255.",
 										Required:            true,
+										Sensitive:           true,
 										Validators: []validator.String{
 											stringvalidator.LengthAtMost(255),
 										},
@@ -2729,6 +2790,7 @@ func (r *objectsExternalDynamicListsResource) Schema(_ context.Context, _ resour
 										Description:         "The `password` parameter. String length must be at most 255.",
 										MarkdownDescription: "The `password` parameter. String length must be at most 255.",
 										Required:            true,
+										Sensitive:           true,
 										Validators: []validator.String{
 											stringvalidator.LengthAtMost(255),
 										},
@@ -2888,6 +2950,7 @@ func (r *objectsExternalDynamicListsResource) Schema(_ context.Context, _ resour
 										Description:         "The `password` parameter. String length must be at most 255.",
 										MarkdownDescription: "The `password` parameter. String length must be at most 255.",
 										Required:            true,
+										Sensitive:           true,
 										Validators: []validator.String{
 											stringvalidator.LengthAtMost(255),
 										},
@@ -3107,6 +3170,7 @@ func (r *objectsExternalDynamicListsResource) Schema(_ context.Context, _ resour
 										Description:         "The `password` parameter. String length must be at most 255.",
 										MarkdownDescription: "The `password` parameter. String length must be at most 255.",
 										Required:            true,
+										Sensitive:           true,
 										Validators: []validator.String{
 											stringvalidator.LengthAtMost(255),
 										},
@@ -3255,6 +3319,65 @@ func (r *objectsExternalDynamicListsResource) Schema(_ context.Context, _ resour
 				},
 			},
 		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_objects_external_dynamic_lists"),
+		},
+	}
+}
+
+// ConfigValidators returns the checks for mutually exclusive attributes.
+func (r *objectsExternalDynamicListsResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		ExactlyOneOfNested(
+			path.MatchRoot("type"),
+			"domain",
+			"imei",
+			"imsi",
+			"ip",
+			"predefined_ip",
+			"predefined_url",
+			"url",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("type").AtName("domain").AtName("recurring"),
+			"daily",
+			"five_minute",
+			"hourly",
+			"monthly",
+			"weekly",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("type").AtName("imei").AtName("recurring"),
+			"daily",
+			"five_minute",
+			"hourly",
+			"monthly",
+			"weekly",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("type").AtName("imsi").AtName("recurring"),
+			"daily",
+			"five_minute",
+			"hourly",
+			"monthly",
+			"weekly",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("type").AtName("ip").AtName("recurring"),
+			"daily",
+			"five_minute",
+			"hourly",
+			"monthly",
+			"weekly",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("type").AtName("url").AtName("recurring"),
+			"daily",
+			"five_minute",
+			"hourly",
+			"monthly",
+			"weekly",
+		),
 	}
 }
 
@@ -3275,6 +3398,9 @@ func (r *objectsExternalDynamicListsResource) Create(ctx context.Context, req re
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_objects_external_dynamic_lists", "create", state.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource create", map[string]any{
 		"terraform_provider_function": "Create",
@@ -3533,7 +3659,7 @@ func (r *objectsExternalDynamicListsResource) Create(ctx context.Context, req re
 	// Perform the operation.
 	ans, err := svc.Create(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in create", err.Error())
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
 		return
 	}
 
@@ -3804,6 +3930,13 @@ func (r *objectsExternalDynamicListsResource) Read(ctx context.Context, req reso
 	}
 
 	var state objectsExternalDynamicListsRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_objects_external_dynamic_lists", "read", state.Timeouts)
+	defer cancel()
 
 	// Basic logging.
 	tflog.Info(ctx, "performing resource read", map[string]any{
@@ -3826,7 +3959,7 @@ func (r *objectsExternalDynamicListsResource) Read(ctx context.Context, req reso
 		if IsObjectNotFound(err) {
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error reading config", err.Error())
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
 		}
 		return
 	}
@@ -4093,6 +4226,9 @@ func (r *objectsExternalDynamicListsResource) Update(ctx context.Context, req re
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_objects_external_dynamic_lists", "update", plan.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource update", map[string]any{
 		"terraform_provider_function": "Update",
@@ -4351,11 +4487,12 @@ func (r *objectsExternalDynamicListsResource) Update(ctx context.Context, req re
 	// Perform the operation.
 	ans, err := svc.Update(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in update", err.Error())
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
 		return
 	}
 
 	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
 	var var34 objectsExternalDynamicListsRsModelTypeObject
 	var var35 *objectsExternalDynamicListsRsModelDomainObject
 	if ans.Type.Domain != nil {
@@ -4609,6 +4746,16 @@ func (r *objectsExternalDynamicListsResource) Delete(ctx context.Context, req re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_objects_external_dynamic_lists", "delete", timeouts)
+	defer cancel()
+
 	id := idType.ValueString()
 	tokens := strings.Split(id, IdSeparator)
 	if len(tokens) != 2 {
@@ -4631,10 +4778,59 @@ func (r *objectsExternalDynamicListsResource) Delete(ctx context.Context, req re
 
 	// Perform the operation.
 	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
-		resp.Diagnostics.AddError("Error in delete", err.Error())
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
 	}
 }
 
+// ImportState imports by either the resource ID or by location params and name,
+// such as `folder=...,name=...`.
 func (r *objectsExternalDynamicListsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	params, ok, err := ParseImportId(req.ID, "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_objects_external_dynamic_lists",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := iHJqznH.NewClient(r.client)
+	input := iHJqznH.ListInput{
+		Folder: params["folder"],
+		Name:   api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
 }
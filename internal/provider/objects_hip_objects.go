@@ -43,10 +43,13 @@ type objectsHipObjectsListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Name   types.String `tfsdk:"name"`
-	Folder types.String `tfsdk:"folder"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Name     types.String `tfsdk:"name"`
+	Folder   types.String `tfsdk:"folder"`
 
 	// Output.
 	Data []objectsHipObjectsListDsModelConfig `tfsdk:"data"`
@@ -483,6 +486,27 @@ func (d *objectsHipObjectsListDataSource) Schema(_ context.Context, _ datasource
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"name": dsschema.StringAttribute{
 				Description:         "The name of the entry.",
 				MarkdownDescription: "The name of the entry.",
@@ -1799,12 +1823,32 @@ func (d *objectsHipObjectsListDataSource) Read(ctx context.Context, req datasour
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -4780,9 +4824,10 @@ func (d *objectsHipObjectsDataSource) Read(ctx context.Context, req datasource.R
 
 // Resource.
 var (
-	_ resource.Resource                = &objectsHipObjectsResource{}
-	_ resource.ResourceWithConfigure   = &objectsHipObjectsResource{}
-	_ resource.ResourceWithImportState = &objectsHipObjectsResource{}
+	_ resource.Resource                     = &objectsHipObjectsResource{}
+	_ resource.ResourceWithConfigure        = &objectsHipObjectsResource{}
+	_ resource.ResourceWithConfigValidators = &objectsHipObjectsResource{}
+	_ resource.ResourceWithImportState      = &objectsHipObjectsResource{}
 )
 
 func NewObjectsHipObjectsResource() resource.Resource {
@@ -4794,7 +4839,8 @@ type objectsHipObjectsResource struct {
 }
 
 type objectsHipObjectsRsModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
 
 	// Input.
 	Folder types.String `tfsdk:"folder"`
@@ -5226,6 +5272,9 @@ func (r *objectsHipObjectsResource) Schema(_ context.Context, _ resource.SchemaR
 				Validators: []validator.String{
 					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 
 			"anti_malware": rsschema.SingleNestedAttribute{
@@ -7417,6 +7466,148 @@ func (r *objectsHipObjectsResource) Schema(_ context.Context, _ resource.SchemaR
 				},
 			},
 		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_objects_hip_objects"),
+		},
+	}
+}
+
+// ConfigValidators returns the checks for mutually exclusive attributes.
+func (r *objectsHipObjectsResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		ExactlyOneOfNested(
+			path.MatchRoot("anti_malware").AtName("criteria").AtName("last_scan_time"),
+			"not_available",
+			"not_within",
+			"within",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("anti_malware").AtName("criteria").AtName("product_version"),
+			"contains",
+			"greater_equal",
+			"greater_than",
+			"is",
+			"is_not",
+			"less_equal",
+			"less_than",
+			"not_within",
+			"within",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("anti_malware").AtName("criteria").AtName("virdef_version"),
+			"not_within",
+			"within",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("disk_backup").AtName("criteria").AtName("last_backup_time"),
+			"not_available",
+			"not_within",
+			"within",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("disk_encryption").AtName("criteria").AtName("encrypted_locations").AtAnyListIndex().AtName("encryption_state"),
+			"is",
+			"is_not",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("host_info").AtName("criteria").AtName("client_version"),
+			"contains",
+			"is",
+			"is_not",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("host_info").AtName("criteria").AtName("domain"),
+			"contains",
+			"is",
+			"is_not",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("host_info").AtName("criteria").AtName("host_id"),
+			"contains",
+			"is",
+			"is_not",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("host_info").AtName("criteria").AtName("host_name"),
+			"contains",
+			"is",
+			"is_not",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("host_info").AtName("criteria").AtName("serial_number"),
+			"contains",
+			"is",
+			"is_not",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("host_info").AtName("criteria").AtName("os").AtName("contains"),
+			"apple",
+			"google",
+			"linux",
+			"microsoft",
+			"other",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("mobile_device").AtName("criteria").AtName("applications").AtName("has_malware"),
+			"no",
+			"yes",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("mobile_device").AtName("criteria").AtName("imei"),
+			"contains",
+			"is",
+			"is_not",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("mobile_device").AtName("criteria").AtName("model"),
+			"contains",
+			"is",
+			"is_not",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("mobile_device").AtName("criteria").AtName("phone_number"),
+			"contains",
+			"is",
+			"is_not",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("mobile_device").AtName("criteria").AtName("tag"),
+			"contains",
+			"is",
+			"is_not",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("mobile_device").AtName("criteria").AtName("last_checkin_time"),
+			"not_within",
+			"within",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("network_info").AtName("criteria").AtName("network"),
+			"is",
+			"is_not",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("network_info").AtName("criteria").AtName("network").AtName("is"),
+			"mobile",
+			"unknown",
+			"wifi",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("network_info").AtName("criteria").AtName("network").AtName("is_not"),
+			"ethernet",
+			"mobile",
+			"unknown",
+			"wifi",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("patch_management").AtName("criteria").AtName("missing_patches").AtName("severity"),
+			"greater_equal",
+			"greater_than",
+			"is",
+			"is_not",
+			"less_equal",
+			"less_than",
+		),
 	}
 }
 
@@ -7437,6 +7628,9 @@ func (r *objectsHipObjectsResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_objects_hip_objects", "create", state.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource create", map[string]any{
 		"terraform_provider_function": "Create",
@@ -8049,7 +8243,7 @@ func (r *objectsHipObjectsResource) Create(ctx context.Context, req resource.Cre
 	// Perform the operation.
 	ans, err := svc.Create(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in create", err.Error())
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
 		return
 	}
 
@@ -8674,6 +8868,13 @@ func (r *objectsHipObjectsResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	var state objectsHipObjectsRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_objects_hip_objects", "read", state.Timeouts)
+	defer cancel()
 
 	// Basic logging.
 	tflog.Info(ctx, "performing resource read", map[string]any{
@@ -8696,7 +8897,7 @@ func (r *objectsHipObjectsResource) Read(ctx context.Context, req resource.ReadR
 		if IsObjectNotFound(err) {
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error reading config", err.Error())
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
 		}
 		return
 	}
@@ -9317,6 +9518,9 @@ func (r *objectsHipObjectsResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_objects_hip_objects", "update", plan.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource update", map[string]any{
 		"terraform_provider_function": "Update",
@@ -9929,11 +10133,12 @@ func (r *objectsHipObjectsResource) Update(ctx context.Context, req resource.Upd
 	// Perform the operation.
 	ans, err := svc.Update(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in update", err.Error())
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
 		return
 	}
 
 	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
 	var var107 *objectsHipObjectsRsModelAntiMalwareObject
 	if ans.AntiMalware != nil {
 		var107 = &objectsHipObjectsRsModelAntiMalwareObject{}
@@ -10541,6 +10746,16 @@ func (r *objectsHipObjectsResource) Delete(ctx context.Context, req resource.Del
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_objects_hip_objects", "delete", timeouts)
+	defer cancel()
+
 	id := idType.ValueString()
 	tokens := strings.Split(id, IdSeparator)
 	if len(tokens) != 2 {
@@ -10563,10 +10778,59 @@ func (r *objectsHipObjectsResource) Delete(ctx context.Context, req resource.Del
 
 	// Perform the operation.
 	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
-		resp.Diagnostics.AddError("Error in delete", err.Error())
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
 	}
 }
 
+// ImportState imports by either the resource ID or by location params and name,
+// such as `folder=...,name=...`.
 func (r *objectsHipObjectsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	params, ok, err := ParseImportId(req.ID, "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_objects_hip_objects",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := yCYVNEN.NewClient(r.client)
+	input := yCYVNEN.ListInput{
+		Folder: params["folder"],
+		Name:   api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
 }
@@ -42,10 +42,13 @@ type objectsServicesListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Name   types.String `tfsdk:"name"`
-	Folder types.String `tfsdk:"folder"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Name     types.String `tfsdk:"name"`
+	Folder   types.String `tfsdk:"folder"`
 
 	// Output.
 	Data []objectsServicesListDsModelConfig `tfsdk:"data"`
@@ -119,6 +122,27 @@ func (d *objectsServicesListDataSource) Schema(_ context.Context, _ datasource.S
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"name": dsschema.StringAttribute{
 				Description:         "The name of the entry.",
 				MarkdownDescription: "The name of the entry.",
@@ -292,12 +316,32 @@ func (d *objectsServicesListDataSource) Read(ctx context.Context, req datasource
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -645,9 +689,10 @@ func (d *objectsServicesDataSource) Read(ctx context.Context, req datasource.Rea
 
 // Resource.
 var (
-	_ resource.Resource                = &objectsServicesResource{}
-	_ resource.ResourceWithConfigure   = &objectsServicesResource{}
-	_ resource.ResourceWithImportState = &objectsServicesResource{}
+	_ resource.Resource                     = &objectsServicesResource{}
+	_ resource.ResourceWithConfigure        = &objectsServicesResource{}
+	_ resource.ResourceWithConfigValidators = &objectsServicesResource{}
+	_ resource.ResourceWithImportState      = &objectsServicesResource{}
 )
 
 func NewObjectsServicesResource() resource.Resource {
@@ -659,7 +704,8 @@ type objectsServicesResource struct {
 }
 
 type objectsServicesRsModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
 
 	// Input.
 	Folder types.String `tfsdk:"folder"`
@@ -728,6 +774,9 @@ func (r *objectsServicesResource) Schema(_ context.Context, _ resource.SchemaReq
 				Validators: []validator.String{
 					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 
 			"description": rsschema.StringAttribute{
@@ -812,22 +861,24 @@ func (r *objectsServicesResource) Schema(_ context.Context, _ resource.SchemaReq
 								},
 							},
 							"port": rsschema.StringAttribute{
-								Description:         "The `port` parameter. String length must be between 1 and 1023.",
-								MarkdownDescription: "The `port` parameter. String length must be between 1 and 1023.",
+								Description:         "The `port` parameter. String length must be between 1 and 1023. Value must be a comma separated list of ports or port ranges from 1 to 65535, such as \"80,443,8000-8080\".",
+								MarkdownDescription: "The `port` parameter. String length must be between 1 and 1023. Value must be a comma separated list of ports or port ranges from 1 to 65535, such as \"80,443,8000-8080\".",
 								Required:            true,
 								Validators: []validator.String{
+									IsPortList(),
 									stringvalidator.LengthBetween(1, 1023),
 								},
 							},
 							"source_port": rsschema.StringAttribute{
-								Description:         "The `source_port` parameter. String length must be between 1 and 1023.",
-								MarkdownDescription: "The `source_port` parameter. String length must be between 1 and 1023.",
+								Description:         "The `source_port` parameter. String length must be between 1 and 1023. Value must be a comma separated list of ports or port ranges from 1 to 65535, such as \"80,443,8000-8080\".",
+								MarkdownDescription: "The `source_port` parameter. String length must be between 1 and 1023. Value must be a comma separated list of ports or port ranges from 1 to 65535, such as \"80,443,8000-8080\".",
 								Optional:            true,
 								Computed:            true,
 								PlanModifiers: []planmodifier.String{
 									DefaultString(""),
 								},
 								Validators: []validator.String{
+									IsPortList(),
 									stringvalidator.LengthBetween(1, 1023),
 								},
 							},
@@ -858,22 +909,24 @@ func (r *objectsServicesResource) Schema(_ context.Context, _ resource.SchemaReq
 								},
 							},
 							"port": rsschema.StringAttribute{
-								Description:         "The `port` parameter. String length must be between 1 and 1023.",
-								MarkdownDescription: "The `port` parameter. String length must be between 1 and 1023.",
+								Description:         "The `port` parameter. String length must be between 1 and 1023. Value must be a comma separated list of ports or port ranges from 1 to 65535, such as \"80,443,8000-8080\".",
+								MarkdownDescription: "The `port` parameter. String length must be between 1 and 1023. Value must be a comma separated list of ports or port ranges from 1 to 65535, such as \"80,443,8000-8080\".",
 								Required:            true,
 								Validators: []validator.String{
+									IsPortList(),
 									stringvalidator.LengthBetween(1, 1023),
 								},
 							},
 							"source_port": rsschema.StringAttribute{
-								Description:         "The `source_port` parameter. String length must be between 1 and 1023.",
-								MarkdownDescription: "The `source_port` parameter. String length must be between 1 and 1023.",
+								Description:         "The `source_port` parameter. String length must be between 1 and 1023. Value must be a comma separated list of ports or port ranges from 1 to 65535, such as \"80,443,8000-8080\".",
+								MarkdownDescription: "The `source_port` parameter. String length must be between 1 and 1023. Value must be a comma separated list of ports or port ranges from 1 to 65535, such as \"80,443,8000-8080\".",
 								Optional:            true,
 								Computed:            true,
 								PlanModifiers: []planmodifier.String{
 									DefaultString(""),
 								},
 								Validators: []validator.String{
+									IsPortList(),
 									stringvalidator.LengthBetween(1, 1023),
 								},
 							},
@@ -888,6 +941,16 @@ func (r *objectsServicesResource) Schema(_ context.Context, _ resource.SchemaReq
 				ElementType:         types.StringType,
 			},
 		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_objects_services"),
+		},
+	}
+}
+
+// ConfigValidators returns the checks for mutually exclusive attributes.
+func (r *objectsServicesResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		ExactlyOneOfNested(path.MatchRoot("protocol"), "tcp", "udp"),
 	}
 }
 
@@ -908,6 +971,9 @@ func (r *objectsServicesResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_objects_services", "create", state.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource create", map[string]any{
 		"terraform_provider_function": "Create",
@@ -959,7 +1025,7 @@ func (r *objectsServicesResource) Create(ctx context.Context, req resource.Creat
 	// Perform the operation.
 	ans, err := svc.Create(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in create", err.Error())
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
 		return
 	}
 
@@ -1023,6 +1089,13 @@ func (r *objectsServicesResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	var state objectsServicesRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_objects_services", "read", state.Timeouts)
+	defer cancel()
 
 	// Basic logging.
 	tflog.Info(ctx, "performing resource read", map[string]any{
@@ -1045,7 +1118,7 @@ func (r *objectsServicesResource) Read(ctx context.Context, req resource.ReadReq
 		if IsObjectNotFound(err) {
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error reading config", err.Error())
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
 		}
 		return
 	}
@@ -1105,6 +1178,9 @@ func (r *objectsServicesResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_objects_services", "update", plan.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource update", map[string]any{
 		"terraform_provider_function": "Update",
@@ -1156,11 +1232,12 @@ func (r *objectsServicesResource) Update(ctx context.Context, req resource.Updat
 	// Perform the operation.
 	ans, err := svc.Update(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in update", err.Error())
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
 		return
 	}
 
 	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
 	var var6 objectsServicesRsModelProtocolObject
 	var var7 *objectsServicesRsModelTcpObject
 	if ans.Protocol.Tcp != nil {
@@ -1207,6 +1284,16 @@ func (r *objectsServicesResource) Delete(ctx context.Context, req resource.Delet
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_objects_services", "delete", timeouts)
+	defer cancel()
+
 	id := idType.ValueString()
 	tokens := strings.Split(id, IdSeparator)
 	if len(tokens) != 2 {
@@ -1229,10 +1316,59 @@ func (r *objectsServicesResource) Delete(ctx context.Context, req resource.Delet
 
 	// Perform the operation.
 	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
-		resp.Diagnostics.AddError("Error in delete", err.Error())
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
 	}
 }
 
+// ImportState imports by either the resource ID or by location params and name,
+// such as `folder=...,name=...`.
 func (r *objectsServicesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	params, ok, err := ParseImportId(req.ID, "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_objects_services",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := eumQbRC.NewClient(r.client)
+	input := eumQbRC.ListInput{
+		Folder: params["folder"],
+		Name:   api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
 }
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	DefaultPageSize = 200
+)
+
+// ListPaging returns if all pages of a listing should be retrieved, along with
+// the page size and max page count to use when doing so.
+//
+// Fetching all pages is enabled by default unless the user has set a limit.  If
+// a limit is set, it is also used as the page size.
+func ListPaging(fetchAll types.Bool, limit, pageSize, maxPages types.Int64) (bool, int64, int64) {
+	enabled := fetchAll.ValueBool() || (fetchAll.IsNull() && limit.IsNull())
+
+	size := int64(DefaultPageSize)
+	switch {
+	case !limit.IsNull():
+		size = limit.ValueInt64()
+	case !pageSize.IsNull():
+		size = pageSize.ValueInt64()
+	}
+
+	return enabled, size, maxPages.ValueInt64()
+}
+
+// FetchAllPages invokes fetch with increasing offsets until all entries have
+// been retrieved, the server returns an empty page, or maxPages pages (including
+// the first page already retrieved) have been read.
+//
+// The fetch func should append the retrieved entries to the listing and return
+// the number of entries on the page.
+func FetchAllPages(offset *int64, count, total, maxPages int64, fetch func(int64) (int, error)) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var start int64
+	if offset != nil {
+		start = *offset
+	}
+
+	for page := int64(1); start+count < total; page++ {
+		if maxPages > 0 && page >= maxPages {
+			diags.AddWarning(
+				"Listing is incomplete",
+				fmt.Sprintf("Stopped after %d pages: retrieved %d of %d entries. Increase max_pages or page_size to get the rest.", page, count, total-start),
+			)
+			break
+		}
+
+		num, err := fetch(start + count)
+		if err != nil {
+			diags.AddError("Error getting listing", err.Error())
+			break
+		}
+		if num == 0 {
+			break
+		}
+		count += int64(num)
+	}
+
+	return diags
+}
@@ -3,14 +3,20 @@ package provider
 import (
 	"context"
 	"fmt"
+	"os"
+	"strconv"
+	"time"
 
 	sdk "github.com/paloaltonetworks/sase-go"
 	sdkapi "github.com/paloaltonetworks/sase-go/api"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -33,6 +39,12 @@ type SaseProviderModel struct {
 	Scope        types.String `tfsdk:"scope"`
 	Logging      types.String `tfsdk:"logging"`
 	AuthFile     types.String `tfsdk:"auth_file"`
+
+	// Retries.
+	MaxRetries        types.Int64   `tfsdk:"max_retries"`
+	MinBackoff        types.String  `tfsdk:"min_backoff"`
+	MaxBackoff        types.String  `tfsdk:"max_backoff"`
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
 }
 
 // Metadata returns the provider type name.
@@ -102,6 +114,48 @@ func (p *SaseProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 				),
 				Optional: true,
 			},
+			"max_retries": schema.Int64Attribute{
+				Description: ProviderParamDescription(
+					"The number of times a request is retried after being throttled or failing with a transient server error. Set to 0 to disable retries.",
+					strconv.Itoa(DefaultMaxRetries),
+					"SASE_MAX_RETRIES",
+					"",
+				),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"min_backoff": schema.StringAttribute{
+				Description: ProviderParamDescription(
+					"The time to wait before the first retry, doubled on each further retry. A `Retry-After` header sent by the API takes precedence.",
+					DefaultMinBackoff,
+					"SASE_MIN_BACKOFF",
+					"",
+				),
+				Optional: true,
+			},
+			"max_backoff": schema.StringAttribute{
+				Description: ProviderParamDescription(
+					"The maximum time to wait between retries, unless a `Retry-After` header sent by the API asks for longer.",
+					DefaultMaxBackoff,
+					"SASE_MAX_BACKOFF",
+					"",
+				),
+				Optional: true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: ProviderParamDescription(
+					"The maximum number of requests per second sent to the API, shared by all resources and data sources. Set to 0 for no limit.",
+					"",
+					"SASE_REQUESTS_PER_SECOND",
+					"",
+				),
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
 		},
 	}
 }
@@ -133,7 +187,13 @@ func (p *SaseProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
-	con.HttpClient.Transport = sdkapi.NewTransport(con.HttpClient.Transport, con)
+	retry, err := retryTransport(config)
+	if err != nil {
+		resp.Diagnostics.AddError("Provider parameter value error", err.Error())
+		return
+	}
+	retry.Transport = sdkapi.NewTransport(con.HttpClient.Transport, con)
+	con.HttpClient.Transport = retry
 
 	if err := con.RefreshJwt(ctx); err != nil {
 		resp.Diagnostics.AddError("Authentication error", err.Error())
@@ -146,6 +206,63 @@ func (p *SaseProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 	tflog.Info(ctx, "Configured client", map[string]any{"success": true})
 }
 
+// retryTransport returns the retry transport for the given config, falling back
+// to environment variables and then defaults for params that are not set.
+func retryTransport(config SaseProviderModel) (*RetryTransport, error) {
+	var err error
+	ans := &RetryTransport{
+		MaxRetries: DefaultMaxRetries,
+	}
+
+	maxRetries := os.Getenv("SASE_MAX_RETRIES")
+	if !config.MaxRetries.IsNull() {
+		maxRetries = Int64ToString(config.MaxRetries.ValueInt64())
+	}
+	if maxRetries != "" {
+		if ans.MaxRetries, err = strconv.Atoi(maxRetries); err != nil || ans.MaxRetries < 0 {
+			return nil, fmt.Errorf("invalid max_retries %q", maxRetries)
+		}
+	}
+
+	backoffs := []struct {
+		name         string
+		value        types.String
+		envName      string
+		defaultValue string
+		dst          *time.Duration
+	}{
+		{"min_backoff", config.MinBackoff, "SASE_MIN_BACKOFF", DefaultMinBackoff, &ans.MinBackoff},
+		{"max_backoff", config.MaxBackoff, "SASE_MAX_BACKOFF", DefaultMaxBackoff, &ans.MaxBackoff},
+	}
+	for _, x := range backoffs {
+		v := x.value.ValueString()
+		if x.value.IsNull() {
+			v = os.Getenv(x.envName)
+		}
+		if v == "" {
+			v = x.defaultValue
+		}
+		if *x.dst, err = time.ParseDuration(v); err != nil || *x.dst < 0 {
+			return nil, fmt.Errorf("invalid %s %q", x.name, v)
+		}
+	}
+	if ans.MinBackoff > ans.MaxBackoff {
+		return nil, fmt.Errorf("min_backoff %s is greater than max_backoff %s", ans.MinBackoff, ans.MaxBackoff)
+	}
+
+	rps := config.RequestsPerSecond.ValueFloat64()
+	if config.RequestsPerSecond.IsNull() {
+		if v := os.Getenv("SASE_REQUESTS_PER_SECOND"); v != "" {
+			if rps, err = strconv.ParseFloat(v, 64); err != nil || rps < 0 {
+				return nil, fmt.Errorf("invalid requests_per_second %q", v)
+			}
+		}
+	}
+	ans.Limiter = NewRateLimiter(rps)
+
+	return ans, nil
+}
+
 // DataSources defines the data sources for this provider.
 func (p *SaseProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
@@ -155,17 +272,21 @@ func (p *SaseProvider) DataSources(_ context.Context) []func() datasource.DataSo
 		NewAntiSpywareSignaturesListDataSource,
 		NewAppOverrideRulesDataSource,
 		NewAppOverrideRulesListDataSource,
+		NewAuthenticationPortalsDataSource,
 		NewAuthenticationPortalsListDataSource,
 		NewAuthenticationProfilesDataSource,
 		NewAuthenticationProfilesListDataSource,
+		NewAuthenticationRulesDataSource,
 		NewAuthenticationRulesListDataSource,
 		NewAuthenticationSequencesDataSource,
 		NewAuthenticationSequencesListDataSource,
 		NewAuthenticationSettingsListDataSource,
+		NewAutoTagActionsDataSource,
 		NewAutoTagActionsListDataSource,
 		NewBandwidthAllocationsListDataSource,
 		NewBgpRoutingListDataSource,
 		NewCandidateConfigVersionsDataSource,
+		NewCertificateExpiryDataSource,
 		NewCertificateProfilesDataSource,
 		NewCertificateProfilesListDataSource,
 		NewCertificatesGetListDataSource,
@@ -245,13 +366,16 @@ func (p *SaseProvider) DataSources(_ context.Context) []func() datasource.DataSo
 		NewScepProfilesListDataSource,
 		NewSecurityRulesDataSource,
 		NewSecurityRulesListDataSource,
+		NewServiceConnectionGroupsDataSource,
 		NewServiceConnectionGroupsListDataSource,
+		NewServiceConnectionsDataSource,
 		NewServiceConnectionsListDataSource,
 		NewSharedInfrastructureSettingsListDataSource,
 		NewTacacsServerProfilesDataSource,
 		NewTacacsServerProfilesListDataSource,
 		NewTlsServiceProfilesDataSource,
 		NewTlsServiceProfilesListDataSource,
+		NewTrafficSteeringRulesDataSource,
 		NewTrafficSteeringRulesListDataSource,
 		NewTrustedCertificateAuthoritiesListDataSource,
 		NewUrlAccessProfilesDataSource,
@@ -273,8 +397,17 @@ func (p *SaseProvider) Resources(_ context.Context) []func() resource.Resource {
 		// Section: netsec
 		NewAntiSpywareProfilesResource,
 		NewAppOverrideRulesResource,
+		NewAuthenticationPortalsResource,
 		NewAuthenticationProfilesResource,
+		NewAuthenticationRulesResource,
 		NewAuthenticationSequencesResource,
+		NewAuthenticationSettingsResource,
+		NewAutoTagActionsResource,
+		NewBandwidthAllocationsResource,
+		NewBgpRoutingResource,
+		NewCandidatePushResource,
+		NewCertificateGenerateResource,
+		NewCertificateImportResource,
 		NewCertificateProfilesResource,
 		NewDecryptionExclusionsResource,
 		NewDecryptionProfilesResource,
@@ -290,9 +423,12 @@ func (p *SaseProvider) Resources(_ context.Context) []func() resource.Resource {
 		NewLdapServerProfilesResource,
 		NewLocalUsersResource,
 		NewMfaServersResource,
+		NewMobileAgentInfrastructureSettingsResource,
+		NewMobileAgentLocationsResource,
 		NewObjectsAddressGroupsResource,
 		NewObjectsAddressesResource,
 		NewObjectsApplicationFiltersResource,
+		NewObjectsApplicationGroupsResource,
 		NewObjectsApplicationsResource,
 		NewObjectsDynamicUserGroupsResource,
 		NewObjectsExternalDynamicListsResource,
@@ -312,9 +448,15 @@ func (p *SaseProvider) Resources(_ context.Context) []func() resource.Resource {
 		NewSamlServerProfilesResource,
 		NewScepProfilesResource,
 		NewSecurityRulesResource,
+		NewSecurityRulesOrderResource,
+		NewServiceConnectionGroupsResource,
+		NewServiceConnectionsResource,
+		NewSharedInfrastructureSettingsResource,
 		NewTacacsServerProfilesResource,
 		NewTlsServiceProfilesResource,
+		NewTrafficSteeringRulesResource,
 		NewUrlAccessProfilesResource,
+		NewUrlCategoriesResource,
 		NewVulnerabilityProtectionProfilesResource,
 		NewVulnerabilityProtectionSignaturesResource,
 		NewWildfireAntiVirusProfilesResource,
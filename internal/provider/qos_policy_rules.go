@@ -10,6 +10,7 @@ import (
 	lNsAvVs "github.com/paloaltonetworks/sase-go/netsec/schema/qos/policy/rules"
 	tzldypq "github.com/paloaltonetworks/sase-go/netsec/service/v1/qospolicyrules"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -43,6 +44,9 @@ type qosPolicyRulesListDsModel struct {
 	// Input.
 	Limit    types.Int64  `tfsdk:"limit"`
 	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
 	Name     types.String `tfsdk:"name"`
 	Folder   types.String `tfsdk:"folder"`
 	Position types.String `tfsdk:"position"`
@@ -135,6 +139,27 @@ func (d *qosPolicyRulesListDataSource) Schema(_ context.Context, _ datasource.Sc
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"name": dsschema.StringAttribute{
 				Description:         "The name of the entry.",
 				MarkdownDescription: "The name of the entry.",
@@ -347,12 +372,32 @@ func (d *qosPolicyRulesListDataSource) Read(ctx context.Context, req datasource.
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -801,9 +846,10 @@ func (d *qosPolicyRulesDataSource) Read(ctx context.Context, req datasource.Read
 
 // Resource.
 var (
-	_ resource.Resource                = &qosPolicyRulesResource{}
-	_ resource.ResourceWithConfigure   = &qosPolicyRulesResource{}
-	_ resource.ResourceWithImportState = &qosPolicyRulesResource{}
+	_ resource.Resource                     = &qosPolicyRulesResource{}
+	_ resource.ResourceWithConfigure        = &qosPolicyRulesResource{}
+	_ resource.ResourceWithConfigValidators = &qosPolicyRulesResource{}
+	_ resource.ResourceWithImportState      = &qosPolicyRulesResource{}
 )
 
 func NewQosPolicyRulesResource() resource.Resource {
@@ -815,7 +861,8 @@ type qosPolicyRulesResource struct {
 }
 
 type qosPolicyRulesRsModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
 
 	// Input.
 	Folder   types.String `tfsdk:"folder"`
@@ -901,6 +948,9 @@ func (r *qosPolicyRulesResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Validators: []validator.String{
 					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"position": rsschema.StringAttribute{
 				Description:         "The position of a security rule. Value must be one of: `\"pre\"`, `\"post\"`.",
@@ -909,6 +959,9 @@ func (r *qosPolicyRulesResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Validators: []validator.String{
 					stringvalidator.OneOf("pre", "post"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 
 			"action": rsschema.SingleNestedAttribute{
@@ -1076,6 +1129,23 @@ func (r *qosPolicyRulesResource) Schema(_ context.Context, _ resource.SchemaRequ
 				},
 			},
 		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_qos_policy_rules"),
+		},
+	}
+}
+
+// ConfigValidators returns the checks for mutually exclusive attributes.
+func (r *qosPolicyRulesResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		ExactlyOneOfNested(
+			path.MatchRoot("dscp_tos").AtName("codepoints").AtAnyListIndex().AtName("type"),
+			"af",
+			"cs",
+			"custom",
+			"ef",
+			"tos",
+		),
 	}
 }
 
@@ -1096,6 +1166,9 @@ func (r *qosPolicyRulesResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_qos_policy_rules", "create", state.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource create", map[string]any{
 		"terraform_provider_function": "Create",
@@ -1176,7 +1249,7 @@ func (r *qosPolicyRulesResource) Create(ctx context.Context, req resource.Create
 	// Perform the operation.
 	ans, err := svc.Create(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in create", err.Error())
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
 		return
 	}
 
@@ -1269,6 +1342,13 @@ func (r *qosPolicyRulesResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	var state qosPolicyRulesRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_qos_policy_rules", "read", state.Timeouts)
+	defer cancel()
 
 	// Basic logging.
 	tflog.Info(ctx, "performing resource read", map[string]any{
@@ -1291,7 +1371,7 @@ func (r *qosPolicyRulesResource) Read(ctx context.Context, req resource.ReadRequ
 		if IsObjectNotFound(err) {
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error reading config", err.Error())
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
 		}
 		return
 	}
@@ -1379,6 +1459,9 @@ func (r *qosPolicyRulesResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_qos_policy_rules", "update", plan.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource update", map[string]any{
 		"terraform_provider_function": "Update",
@@ -1457,11 +1540,12 @@ func (r *qosPolicyRulesResource) Update(ctx context.Context, req resource.Update
 	// Perform the operation.
 	ans, err := svc.Update(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in update", err.Error())
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
 		return
 	}
 
 	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
 	var var12 qosPolicyRulesRsModelActionObject
 	var12.Class = types.StringValue(ans.Action.Class)
 	var var13 *qosPolicyRulesRsModelDscpTosObject
@@ -1535,6 +1619,16 @@ func (r *qosPolicyRulesResource) Delete(ctx context.Context, req resource.Delete
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_qos_policy_rules", "delete", timeouts)
+	defer cancel()
+
 	id := idType.ValueString()
 	tokens := strings.Split(id, IdSeparator)
 	if len(tokens) != 3 {
@@ -1557,10 +1651,62 @@ func (r *qosPolicyRulesResource) Delete(ctx context.Context, req resource.Delete
 
 	// Perform the operation.
 	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
-		resp.Diagnostics.AddError("Error in delete", err.Error())
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
 	}
 }
 
+// ImportState imports by either the resource ID or by location params and name,
+// such as `folder=...,position=...,name=...`.
 func (r *qosPolicyRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	params, ok, err := ParseImportId(req.ID, "folder", "position", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_qos_policy_rules",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := tzldypq.NewClient(r.client)
+	input := tzldypq.ListInput{
+		Folder:   params["folder"],
+		Position: params["position"],
+		Name:     api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(params["position"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
 }
@@ -42,10 +42,13 @@ type radiusServerProfilesListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Folder types.String `tfsdk:"folder"`
-	Name   types.String `tfsdk:"name"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Folder   types.String `tfsdk:"folder"`
+	Name     types.String `tfsdk:"name"`
 
 	// Output.
 	Data []radiusServerProfilesListDsModelConfig `tfsdk:"data"`
@@ -123,6 +126,27 @@ func (d *radiusServerProfilesListDataSource) Schema(_ context.Context, _ datasou
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"folder": dsschema.StringAttribute{
 				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
 				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
@@ -252,6 +276,7 @@ func (d *radiusServerProfilesListDataSource) Schema(_ context.Context, _ datasou
 										Description:         "The `secret` parameter.",
 										MarkdownDescription: "The `secret` parameter.",
 										Computed:            true,
+										Sensitive:           true,
 									},
 								},
 							},
@@ -317,12 +342,32 @@ func (d *radiusServerProfilesListDataSource) Read(ctx context.Context, req datas
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -613,6 +658,7 @@ func (d *radiusServerProfilesDataSource) Schema(_ context.Context, _ datasource.
 							Description:         "The `secret` parameter.",
 							MarkdownDescription: "The `secret` parameter.",
 							Computed:            true,
+							Sensitive:           true,
 						},
 					},
 				},
@@ -727,9 +773,10 @@ func (d *radiusServerProfilesDataSource) Read(ctx context.Context, req datasourc
 
 // Resource.
 var (
-	_ resource.Resource                = &radiusServerProfilesResource{}
-	_ resource.ResourceWithConfigure   = &radiusServerProfilesResource{}
-	_ resource.ResourceWithImportState = &radiusServerProfilesResource{}
+	_ resource.Resource                     = &radiusServerProfilesResource{}
+	_ resource.ResourceWithConfigure        = &radiusServerProfilesResource{}
+	_ resource.ResourceWithConfigValidators = &radiusServerProfilesResource{}
+	_ resource.ResourceWithImportState      = &radiusServerProfilesResource{}
 )
 
 func NewRadiusServerProfilesResource() resource.Resource {
@@ -741,11 +788,16 @@ type radiusServerProfilesResource struct {
 }
 
 type radiusServerProfilesRsModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
 
 	// Input.
 	Folder types.String `tfsdk:"folder"`
 
+	// Secrets.
+	SecretHashes  map[string]types.String `tfsdk:"secret_hashes"`
+	SecretVersion types.String            `tfsdk:"secret_version"`
+
 	// Request body input.
 	// Ref: #/components/schemas/radius-server-profiles
 	ObjectId types.String                               `tfsdk:"object_id"`
@@ -786,6 +838,16 @@ type radiusServerProfilesRsModelServerObject struct {
 	Secret    types.String `tfsdk:"secret"`
 }
 
+// secrets returns the write-only secrets in the model, keyed by location.
+func (o *radiusServerProfilesRsModel) secrets() map[string]*types.String {
+	ans := make(map[string]*types.String, len(o.Server))
+	for i := range o.Server {
+		ans["server."+o.Server[i].Name.ValueString()+".secret"] = &o.Server[i].Secret
+	}
+
+	return ans
+}
+
 // Metadata returns the data source type name.
 func (r *radiusServerProfilesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_radius_server_profiles"
@@ -814,6 +876,22 @@ func (r *radiusServerProfilesResource) Schema(_ context.Context, _ resource.Sche
 				Validators: []validator.String{
 					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Secrets.
+			"secret_hashes": rsschema.MapAttribute{
+				Description:         "Hashes of the encrypted secrets returned by the server, used to detect secrets changed outside of Terraform.",
+				MarkdownDescription: "Hashes of the encrypted secrets returned by the server, used to detect secrets changed outside of Terraform.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"secret_version": rsschema.StringAttribute{
+				Description:         "An arbitrary value that, when changed, forces all secrets to be sent to the server again.",
+				MarkdownDescription: "An arbitrary value that, when changed, forces all secrets to be sent to the server again.",
+				Optional:            true,
 			},
 
 			"object_id": rsschema.StringAttribute{
@@ -944,13 +1022,16 @@ func (r *radiusServerProfilesResource) Schema(_ context.Context, _ resource.Sche
 				NestedObject: rsschema.NestedAttributeObject{
 					Attributes: map[string]rsschema.Attribute{
 						"ip_address": rsschema.StringAttribute{
-							Description:         "The `ip_address` parameter.",
-							MarkdownDescription: "The `ip_address` parameter.",
+							Description:         "The `ip_address` parameter. Value must be an IPv4 or IPv6 address or a fully qualified domain name.",
+							MarkdownDescription: "The `ip_address` parameter. Value must be an IPv4 or IPv6 address or a fully qualified domain name.",
 							Optional:            true,
 							Computed:            true,
 							PlanModifiers: []planmodifier.String{
 								DefaultString(""),
 							},
+							Validators: []validator.String{
+								IsIpAddressOrFqdn(),
+							},
 						},
 						"name": rsschema.StringAttribute{
 							Description:         "The `name` parameter.",
@@ -978,6 +1059,7 @@ func (r *radiusServerProfilesResource) Schema(_ context.Context, _ resource.Sche
 							MarkdownDescription: "The `secret` parameter. String length must be at most 64.",
 							Optional:            true,
 							Computed:            true,
+							Sensitive:           true,
 							PlanModifiers: []planmodifier.String{
 								DefaultString(""),
 							},
@@ -1001,6 +1083,23 @@ func (r *radiusServerProfilesResource) Schema(_ context.Context, _ resource.Sche
 				},
 			},
 		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_radius_server_profiles"),
+		},
+	}
+}
+
+// ConfigValidators returns the checks for mutually exclusive attributes.
+func (r *radiusServerProfilesResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		ExactlyOneOfNested(
+			path.MatchRoot("protocol"),
+			"c_h_a_p",
+			"e_a_p_t_t_l_s_with_p_a_p",
+			"p_a_p",
+			"p_e_a_p_m_s_c_h_a_pv2",
+			"p_e_a_p_with_g_t_c",
+		),
 	}
 }
 
@@ -1021,6 +1120,9 @@ func (r *radiusServerProfilesResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_radius_server_profiles", "create", state.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource create", map[string]any{
 		"terraform_provider_function": "Create",
@@ -1088,10 +1190,13 @@ func (r *radiusServerProfilesResource) Create(ctx context.Context, req resource.
 	// Perform the operation.
 	ans, err := svc.Create(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in create", err.Error())
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
 		return
 	}
 
+	// Save the plaintext secrets before they are overwritten.
+	plan := state
+
 	// Store the answer to state.
 	var idBuilder strings.Builder
 	idBuilder.WriteString(input.Folder)
@@ -1149,6 +1254,9 @@ func (r *radiusServerProfilesResource) Create(ctx context.Context, req resource.
 	state.Server = var12
 	state.Timeout = types.Int64Value(ans.Timeout)
 
+	// Keep the plaintext secrets instead of what the server returned.
+	state.SecretHashes = SaveSecrets(state.secrets(), plan.secrets())
+
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -1168,6 +1276,13 @@ func (r *radiusServerProfilesResource) Read(ctx context.Context, req resource.Re
 	}
 
 	var state radiusServerProfilesRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_radius_server_profiles", "read", state.Timeouts)
+	defer cancel()
 
 	// Basic logging.
 	tflog.Info(ctx, "performing resource read", map[string]any{
@@ -1190,7 +1305,7 @@ func (r *radiusServerProfilesResource) Read(ctx context.Context, req resource.Re
 		if IsObjectNotFound(err) {
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error reading config", err.Error())
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
 		}
 		return
 	}
@@ -1249,6 +1364,15 @@ func (r *radiusServerProfilesResource) Read(ctx context.Context, req resource.Re
 	state.Server = var4
 	state.Timeout = types.Int64Value(ans.Timeout)
 
+	// Keep the plaintext secrets if they are unchanged on the server.
+	var prior radiusServerProfilesRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.SecretHashes = RefreshSecrets(state.secrets(), prior.secrets(), prior.SecretHashes)
+	state.SecretVersion = prior.SecretVersion
+
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -1266,6 +1390,9 @@ func (r *radiusServerProfilesResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_radius_server_profiles", "update", plan.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource update", map[string]any{
 		"terraform_provider_function": "Update",
@@ -1333,11 +1460,12 @@ func (r *radiusServerProfilesResource) Update(ctx context.Context, req resource.
 	// Perform the operation.
 	ans, err := svc.Update(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in update", err.Error())
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
 		return
 	}
 
 	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
 	var var8 *radiusServerProfilesRsModelProtocolObject
 	if ans.Protocol != nil {
 		var8 = &radiusServerProfilesRsModelProtocolObject{}
@@ -1389,6 +1517,10 @@ func (r *radiusServerProfilesResource) Update(ctx context.Context, req resource.
 	state.Server = var12
 	state.Timeout = types.Int64Value(ans.Timeout)
 
+	// Keep the plaintext secrets instead of what the server returned.
+	state.SecretHashes = SaveSecrets(state.secrets(), plan.secrets())
+	state.SecretVersion = plan.SecretVersion
+
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -1400,6 +1532,16 @@ func (r *radiusServerProfilesResource) Delete(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_radius_server_profiles", "delete", timeouts)
+	defer cancel()
+
 	id := idType.ValueString()
 	tokens := strings.Split(id, IdSeparator)
 	if len(tokens) != 2 {
@@ -1422,10 +1564,58 @@ func (r *radiusServerProfilesResource) Delete(ctx context.Context, req resource.
 
 	// Perform the operation.
 	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
-		resp.Diagnostics.AddError("Error in delete", err.Error())
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
 	}
 }
 
+// ImportState imports by either the resource ID or by location params and name,
+// such as `folder=...,name=...`.
 func (r *radiusServerProfilesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	params, ok, err := ParseImportId(req.ID, "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_radius_server_profiles",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := bVmbuOb.NewClient(r.client)
+	input := bVmbuOb.ListInput{
+		Folder: params["folder"],
+		Name:   api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	// The listing does not return the name, so rely on the server side filter.
+	var ids []string
+	for _, x := range ans.Data {
+		ids = append(ids, x.ObjectId)
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
 }
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/paloaltonetworks/sase-go"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ReferenceLookup reports whether an object with the given name exists in the
+// given folder.
+type ReferenceLookup func(ctx context.Context, client *sase.Client, folder, name string) (bool, error)
+
+// folderParents maps each folder to the folder that it inherits config from.
+var folderParents = map[string]string{
+	"Mobile Users":                "Mobile Users Container",
+	"Mobile Users Explicit Proxy": "Mobile Users Container",
+	"Mobile Users Container":      "Shared",
+	"Remote Networks":             "Shared",
+	"Service Connections":         "Shared",
+}
+
+// FolderAncestry returns the folder followed by the folders that it inherits
+// config from, nearest first.  Folders not in the known hierarchy inherit from
+// "Shared".
+func FolderAncestry(folder string) []string {
+	ans := []string{folder}
+	for folder != "Shared" {
+		parent, ok := folderParents[folder]
+		if !ok {
+			parent = "Shared"
+		}
+		ans = append(ans, parent)
+		folder = parent
+	}
+
+	return ans
+}
+
+// CheckReference adds an error if the object named by value can't be found
+// in the folder or any of the folders that it inherits from.  Null, unknown,
+// and empty values are not checked.
+func CheckReference(ctx context.Context, diags *diag.Diagnostics, client *sase.Client, p path.Path, kind, folder string, value types.String, lookup ReferenceLookup) {
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return
+	}
+
+	folders := FolderAncestry(folder)
+
+	name := value.ValueString()
+	for _, x := range folders {
+		ok, err := lookup(ctx, client, x, name)
+		if err != nil {
+			diags.AddAttributeError(p, "Error checking reference", OperationError(ctx, err))
+			return
+		}
+		if ok {
+			return
+		}
+	}
+
+	diags.AddAttributeError(
+		p,
+		"Invalid Reference",
+		fmt.Sprintf("The %s %q does not exist in folder %s.", kind, name, strings.Join(quoteAll(folders), " or ")),
+	)
+}
+
+// CheckPlanReference runs CheckReference from ModifyPlan on the planned value
+// of the string attribute at p, in the folder at folderPath.  Nothing is
+// looked up when the folder is unknown, or when neither the value nor the
+// folder changed from the prior state.
+func CheckPlanReference(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, client *sase.Client, p path.Path, kind string, folderPath path.Path, lookup ReferenceLookup) {
+	var value, folder types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, p, &value)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, folderPath, &folder)...)
+	if resp.Diagnostics.HasError() || folder.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var priorValue, priorFolder types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, p, &priorValue)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, folderPath, &priorFolder)...)
+		if resp.Diagnostics.HasError() || (priorValue.Equal(value) && priorFolder.Equal(folder)) {
+			return
+		}
+	}
+
+	CheckReference(ctx, &resp.Diagnostics, client, p, kind, folder.ValueString(), value, lookup)
+}
+
+func quoteAll(v []string) []string {
+	ans := make([]string, 0, len(v))
+	for _, x := range v {
+		ans = append(ans, fmt.Sprintf("%q", x))
+	}
+
+	return ans
+}
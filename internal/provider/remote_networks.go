@@ -10,6 +10,8 @@ import (
 	jhtSIUK "github.com/paloaltonetworks/sase-go/netsec/schema/remote/networks"
 	xsuBWMo "github.com/paloaltonetworks/sase-go/netsec/service/v1/remotenetworks"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -41,10 +43,13 @@ type remoteNetworksListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Name   types.String `tfsdk:"name"`
-	Folder types.String `tfsdk:"folder"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Name     types.String `tfsdk:"name"`
+	Folder   types.String `tfsdk:"folder"`
 
 	// Output.
 	Data []remoteNetworksListDsModelConfig `tfsdk:"data"`
@@ -133,6 +138,27 @@ func (d *remoteNetworksListDataSource) Schema(_ context.Context, _ datasource.Sc
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"name": dsschema.StringAttribute{
 				Description:         "The name of the entry.",
 				MarkdownDescription: "The name of the entry.",
@@ -209,6 +235,7 @@ func (d *remoteNetworksListDataSource) Schema(_ context.Context, _ datasource.Sc
 										Description:         "The `secret` parameter.",
 										MarkdownDescription: "The `secret` parameter.",
 										Computed:            true,
+										Sensitive:           true,
 									},
 									"summarize_mobile_user_routes": dsschema.BoolAttribute{
 										Description:         "The `summarize_mobile_user_routes` parameter.",
@@ -287,6 +314,7 @@ func (d *remoteNetworksListDataSource) Schema(_ context.Context, _ datasource.Sc
 											Description:         "The `secret` parameter.",
 											MarkdownDescription: "The `secret` parameter.",
 											Computed:            true,
+											Sensitive:           true,
 										},
 										"summarize_mobile_user_routes": dsschema.BoolAttribute{
 											Description:         "The `summarize_mobile_user_routes` parameter.",
@@ -314,6 +342,7 @@ func (d *remoteNetworksListDataSource) Schema(_ context.Context, _ datasource.Sc
 											Description:         "The `secret` parameter.",
 											MarkdownDescription: "The `secret` parameter.",
 											Computed:            true,
+											Sensitive:           true,
 										},
 									},
 								},
@@ -396,12 +425,32 @@ func (d *remoteNetworksListDataSource) Read(ctx context.Context, req datasource.
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -661,6 +710,7 @@ func (d *remoteNetworksDataSource) Schema(_ context.Context, _ datasource.Schema
 							Description:         "The `secret` parameter.",
 							MarkdownDescription: "The `secret` parameter.",
 							Computed:            true,
+							Sensitive:           true,
 						},
 						"summarize_mobile_user_routes": dsschema.BoolAttribute{
 							Description:         "The `summarize_mobile_user_routes` parameter.",
@@ -734,6 +784,7 @@ func (d *remoteNetworksDataSource) Schema(_ context.Context, _ datasource.Schema
 								Description:         "The `secret` parameter.",
 								MarkdownDescription: "The `secret` parameter.",
 								Computed:            true,
+								Sensitive:           true,
 							},
 							"summarize_mobile_user_routes": dsschema.BoolAttribute{
 								Description:         "The `summarize_mobile_user_routes` parameter.",
@@ -761,6 +812,7 @@ func (d *remoteNetworksDataSource) Schema(_ context.Context, _ datasource.Schema
 								Description:         "The `secret` parameter.",
 								MarkdownDescription: "The `secret` parameter.",
 								Computed:            true,
+								Sensitive:           true,
 							},
 						},
 					},
@@ -912,7 +964,8 @@ type remoteNetworksResource struct {
 }
 
 type remoteNetworksRsModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
 
 	// Input.
 	Folder types.String `tfsdk:"folder"`
@@ -996,6 +1049,9 @@ func (r *remoteNetworksResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Validators: []validator.String{
 					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 
 			"ecmp_load_balancing": rsschema.StringAttribute{
@@ -1031,13 +1087,16 @@ func (r *remoteNetworksResource) Schema(_ context.Context, _ resource.SchemaRequ
 							Required:            true,
 						},
 						"local_ip_address": rsschema.StringAttribute{
-							Description:         "The `local_ip_address` parameter.",
-							MarkdownDescription: "The `local_ip_address` parameter.",
+							Description:         "The `local_ip_address` parameter. Value must be an IPv4 or IPv6 address.",
+							MarkdownDescription: "The `local_ip_address` parameter. Value must be an IPv4 or IPv6 address.",
 							Optional:            true,
 							Computed:            true,
 							PlanModifiers: []planmodifier.String{
 								DefaultString(""),
 							},
+							Validators: []validator.String{
+								IsIpAddress(),
+							},
 						},
 						"name": rsschema.StringAttribute{
 							Description:         "The `name` parameter.",
@@ -1054,22 +1113,28 @@ func (r *remoteNetworksResource) Schema(_ context.Context, _ resource.SchemaRequ
 							},
 						},
 						"peer_as": rsschema.StringAttribute{
-							Description:         "The `peer_as` parameter.",
-							MarkdownDescription: "The `peer_as` parameter.",
+							Description:         "The `peer_as` parameter. Value must be a 2-byte or 4-byte AS number, in plain or dotted notation.",
+							MarkdownDescription: "The `peer_as` parameter. Value must be a 2-byte or 4-byte AS number, in plain or dotted notation.",
 							Optional:            true,
 							Computed:            true,
 							PlanModifiers: []planmodifier.String{
 								DefaultString(""),
 							},
+							Validators: []validator.String{
+								IsAsn(),
+							},
 						},
 						"peer_ip_address": rsschema.StringAttribute{
-							Description:         "The `peer_ip_address` parameter.",
-							MarkdownDescription: "The `peer_ip_address` parameter.",
+							Description:         "The `peer_ip_address` parameter. Value must be an IPv4 or IPv6 address.",
+							MarkdownDescription: "The `peer_ip_address` parameter. Value must be an IPv4 or IPv6 address.",
 							Optional:            true,
 							Computed:            true,
 							PlanModifiers: []planmodifier.String{
 								DefaultString(""),
 							},
+							Validators: []validator.String{
+								IsIpAddress(),
+							},
 						},
 						"peering_type": rsschema.StringAttribute{
 							Description:         "The `peering_type` parameter. Value must be one of: `\"exchange-v4-over-v4\"`, `\"exchange-v4-v6-over-v4\"`, `\"exchange-v4-over-v4-v6-over-v6\"`, `\"exchange-v6-over-v6\"`.",
@@ -1088,6 +1153,7 @@ func (r *remoteNetworksResource) Schema(_ context.Context, _ resource.SchemaRequ
 							MarkdownDescription: "The `secret` parameter.",
 							Optional:            true,
 							Computed:            true,
+							Sensitive:           true,
 							PlanModifiers: []planmodifier.String{
 								DefaultString(""),
 							},
@@ -1166,13 +1232,16 @@ func (r *remoteNetworksResource) Schema(_ context.Context, _ resource.SchemaRequ
 								},
 							},
 							"local_ip_address": rsschema.StringAttribute{
-								Description:         "The `local_ip_address` parameter.",
-								MarkdownDescription: "The `local_ip_address` parameter.",
+								Description:         "The `local_ip_address` parameter. Value must be an IPv4 or IPv6 address.",
+								MarkdownDescription: "The `local_ip_address` parameter. Value must be an IPv4 or IPv6 address.",
 								Optional:            true,
 								Computed:            true,
 								PlanModifiers: []planmodifier.String{
 									DefaultString(""),
 								},
+								Validators: []validator.String{
+									IsIpAddress(),
+								},
 							},
 							"originate_default_route": rsschema.BoolAttribute{
 								Description:         "The `originate_default_route` parameter.",
@@ -1184,22 +1253,28 @@ func (r *remoteNetworksResource) Schema(_ context.Context, _ resource.SchemaRequ
 								},
 							},
 							"peer_as": rsschema.StringAttribute{
-								Description:         "The `peer_as` parameter.",
-								MarkdownDescription: "The `peer_as` parameter.",
+								Description:         "The `peer_as` parameter. Value must be a 2-byte or 4-byte AS number, in plain or dotted notation.",
+								MarkdownDescription: "The `peer_as` parameter. Value must be a 2-byte or 4-byte AS number, in plain or dotted notation.",
 								Optional:            true,
 								Computed:            true,
 								PlanModifiers: []planmodifier.String{
 									DefaultString(""),
 								},
+								Validators: []validator.String{
+									IsAsn(),
+								},
 							},
 							"peer_ip_address": rsschema.StringAttribute{
-								Description:         "The `peer_ip_address` parameter.",
-								MarkdownDescription: "The `peer_ip_address` parameter.",
+								Description:         "The `peer_ip_address` parameter. Value must be an IPv4 or IPv6 address.",
+								MarkdownDescription: "The `peer_ip_address` parameter. Value must be an IPv4 or IPv6 address.",
 								Optional:            true,
 								Computed:            true,
 								PlanModifiers: []planmodifier.String{
 									DefaultString(""),
 								},
+								Validators: []validator.String{
+									IsIpAddress(),
+								},
 							},
 							"peering_type": rsschema.StringAttribute{
 								Description:         "The `peering_type` parameter. Value must be one of: `\"exchange-v4-over-v4\"`, `\"exchange-v4-v6-over-v4\"`, `\"exchange-v4-over-v4-v6-over-v6\"`, `\"exchange-v6-over-v6\"`.",
@@ -1218,6 +1293,7 @@ func (r *remoteNetworksResource) Schema(_ context.Context, _ resource.SchemaRequ
 								MarkdownDescription: "The `secret` parameter.",
 								Optional:            true,
 								Computed:            true,
+								Sensitive:           true,
 								PlanModifiers: []planmodifier.String{
 									DefaultString(""),
 								},
@@ -1239,28 +1315,35 @@ func (r *remoteNetworksResource) Schema(_ context.Context, _ resource.SchemaRequ
 						Optional:            true,
 						Attributes: map[string]rsschema.Attribute{
 							"local_ip_address": rsschema.StringAttribute{
-								Description:         "The `local_ip_address` parameter.",
-								MarkdownDescription: "The `local_ip_address` parameter.",
+								Description:         "The `local_ip_address` parameter. Value must be an IPv4 or IPv6 address.",
+								MarkdownDescription: "The `local_ip_address` parameter. Value must be an IPv4 or IPv6 address.",
 								Optional:            true,
 								Computed:            true,
 								PlanModifiers: []planmodifier.String{
 									DefaultString(""),
 								},
+								Validators: []validator.String{
+									IsIpAddress(),
+								},
 							},
 							"peer_ip_address": rsschema.StringAttribute{
-								Description:         "The `peer_ip_address` parameter.",
-								MarkdownDescription: "The `peer_ip_address` parameter.",
+								Description:         "The `peer_ip_address` parameter. Value must be an IPv4 or IPv6 address.",
+								MarkdownDescription: "The `peer_ip_address` parameter. Value must be an IPv4 or IPv6 address.",
 								Optional:            true,
 								Computed:            true,
 								PlanModifiers: []planmodifier.String{
 									DefaultString(""),
 								},
+								Validators: []validator.String{
+									IsIpAddress(),
+								},
 							},
 							"secret": rsschema.StringAttribute{
 								Description:         "The `secret` parameter.",
 								MarkdownDescription: "The `secret` parameter.",
 								Optional:            true,
 								Computed:            true,
+								Sensitive:           true,
 								PlanModifiers: []planmodifier.String{
 									DefaultString(""),
 								},
@@ -1296,12 +1379,18 @@ func (r *remoteNetworksResource) Schema(_ context.Context, _ resource.SchemaRequ
 				},
 			},
 			"subnets": rsschema.ListAttribute{
-				Description:         "The `subnets` parameter.",
-				MarkdownDescription: "The `subnets` parameter.",
+				Description:         "The `subnets` parameter. Each value must be an IPv4 or IPv6 prefix in CIDR notation.",
+				MarkdownDescription: "The `subnets` parameter. Each value must be an IPv4 or IPv6 prefix in CIDR notation.",
 				Optional:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(IsIpPrefix()),
+				},
 			},
 		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_remote_networks"),
+		},
 	}
 }
 
@@ -1322,6 +1411,9 @@ func (r *remoteNetworksResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_remote_networks", "create", state.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource create", map[string]any{
 		"terraform_provider_function": "Create",
@@ -1395,7 +1487,7 @@ func (r *remoteNetworksResource) Create(ctx context.Context, req resource.Create
 	// Perform the operation.
 	ans, err := svc.Create(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in create", err.Error())
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
 		return
 	}
 
@@ -1481,6 +1573,13 @@ func (r *remoteNetworksResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	var state remoteNetworksRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_remote_networks", "read", state.Timeouts)
+	defer cancel()
 
 	// Basic logging.
 	tflog.Info(ctx, "performing resource read", map[string]any{
@@ -1503,7 +1602,7 @@ func (r *remoteNetworksResource) Read(ctx context.Context, req resource.ReadRequ
 		if IsObjectNotFound(err) {
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error reading config", err.Error())
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
 		}
 		return
 	}
@@ -1585,6 +1684,9 @@ func (r *remoteNetworksResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_remote_networks", "update", plan.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource update", map[string]any{
 		"terraform_provider_function": "Update",
@@ -1658,11 +1760,12 @@ func (r *remoteNetworksResource) Update(ctx context.Context, req resource.Update
 	// Perform the operation.
 	ans, err := svc.Update(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in update", err.Error())
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
 		return
 	}
 
 	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
 	var var7 []remoteNetworksRsModelEcmpTunnelsObject
 	if len(ans.EcmpTunnels) != 0 {
 		var7 = make([]remoteNetworksRsModelEcmpTunnelsObject, 0, len(ans.EcmpTunnels))
@@ -1731,6 +1834,16 @@ func (r *remoteNetworksResource) Delete(ctx context.Context, req resource.Delete
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_remote_networks", "delete", timeouts)
+	defer cancel()
+
 	id := idType.ValueString()
 	tokens := strings.Split(id, IdSeparator)
 	if len(tokens) != 2 {
@@ -1753,10 +1866,59 @@ func (r *remoteNetworksResource) Delete(ctx context.Context, req resource.Delete
 
 	// Perform the operation.
 	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
-		resp.Diagnostics.AddError("Error in delete", err.Error())
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
 	}
 }
 
+// ImportState imports by either the resource ID or by location params and name,
+// such as `folder=...,name=...`.
 func (r *remoteNetworksResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	params, ok, err := ParseImportId(req.ID, "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_remote_networks",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := xsuBWMo.NewClient(r.client)
+	input := xsuBWMo.ListInput{
+		Folder: params["folder"],
+		Name:   api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
 }
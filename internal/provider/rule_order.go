@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// RuleDestinations are the places within its rulebase that a rule can be moved
+// to.  The "before" and "after" destinations are relative to another rule.
+var RuleDestinations = []string{"top", "bottom", "before", "after"}
+
+var _ resource.ConfigValidator = &ruleMoveValidator{}
+
+// RuleMoveValidator returns a config validator that checks that the rule given
+// by target is configured if, and only if, the destination is relative to
+// another rule.
+func RuleMoveValidator(destination, target path.Path) resource.ConfigValidator {
+	return &ruleMoveValidator{
+		destination: destination,
+		target:      target,
+	}
+}
+
+type ruleMoveValidator struct {
+	destination path.Path
+	target      path.Path
+}
+
+func (v ruleMoveValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v ruleMoveValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("%s must be configured if %s is \"before\" or \"after\", and only then", v.target, v.destination)
+}
+
+func (v ruleMoveValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var destination, target types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, v.destination, &destination)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, v.target, &target)...)
+	if resp.Diagnostics.HasError() || destination.IsUnknown() || target.IsUnknown() {
+		return
+	}
+
+	relative := destination.ValueString() == "before" || destination.ValueString() == "after"
+	switch {
+	case relative && target.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			v.target,
+			"Missing Attribute Configuration",
+			fmt.Sprintf("%s must be configured when %s is %q.", v.target, v.destination, destination.ValueString()),
+		)
+	case !relative && !target.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			v.target,
+			"Invalid Attribute Combination",
+			fmt.Sprintf("%s can only be configured when %s is \"before\" or \"after\".", v.target, v.destination),
+		)
+	}
+}
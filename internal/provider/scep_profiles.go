@@ -10,6 +10,7 @@ import (
 	qmgDayz "github.com/paloaltonetworks/sase-go/netsec/schema/scep/profiles"
 	xlSkOUa "github.com/paloaltonetworks/sase-go/netsec/service/v1/scepprofiles"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -41,10 +42,13 @@ type scepProfilesListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Name   types.String `tfsdk:"name"`
-	Folder types.String `tfsdk:"folder"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Name     types.String `tfsdk:"name"`
+	Folder   types.String `tfsdk:"folder"`
 
 	// Output.
 	Data []scepProfilesListDsModelConfig `tfsdk:"data"`
@@ -126,6 +130,27 @@ func (d *scepProfilesListDataSource) Schema(_ context.Context, _ datasource.Sche
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"name": dsschema.StringAttribute{
 				Description:         "The name of the entry.",
 				MarkdownDescription: "The name of the entry.",
@@ -237,6 +262,7 @@ func (d *scepProfilesListDataSource) Schema(_ context.Context, _ datasource.Sche
 											Description:         "The `password` parameter.",
 											MarkdownDescription: "The `password` parameter.",
 											Computed:            true,
+											Sensitive:           true,
 										},
 										"username": dsschema.StringAttribute{
 											Description:         "The `username` parameter.",
@@ -249,6 +275,7 @@ func (d *scepProfilesListDataSource) Schema(_ context.Context, _ datasource.Sche
 									Description:         "The `fixed` parameter.",
 									MarkdownDescription: "The `fixed` parameter.",
 									Computed:            true,
+									Sensitive:           true,
 								},
 								"none": dsschema.StringAttribute{
 									Description:         "The `none` parameter.",
@@ -338,12 +365,32 @@ func (d *scepProfilesListDataSource) Read(ctx context.Context, req datasource.Re
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -606,6 +653,7 @@ func (d *scepProfilesDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 								Description:         "The `password` parameter.",
 								MarkdownDescription: "The `password` parameter.",
 								Computed:            true,
+								Sensitive:           true,
 							},
 							"username": dsschema.StringAttribute{
 								Description:         "The `username` parameter.",
@@ -618,6 +666,7 @@ func (d *scepProfilesDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 						Description:         "The `fixed` parameter.",
 						MarkdownDescription: "The `fixed` parameter.",
 						Computed:            true,
+						Sensitive:           true,
 					},
 					"none": dsschema.StringAttribute{
 						Description:         "The `none` parameter.",
@@ -747,9 +796,10 @@ func (d *scepProfilesDataSource) Read(ctx context.Context, req datasource.ReadRe
 
 // Resource.
 var (
-	_ resource.Resource                = &scepProfilesResource{}
-	_ resource.ResourceWithConfigure   = &scepProfilesResource{}
-	_ resource.ResourceWithImportState = &scepProfilesResource{}
+	_ resource.Resource                     = &scepProfilesResource{}
+	_ resource.ResourceWithConfigure        = &scepProfilesResource{}
+	_ resource.ResourceWithConfigValidators = &scepProfilesResource{}
+	_ resource.ResourceWithImportState      = &scepProfilesResource{}
 )
 
 func NewScepProfilesResource() resource.Resource {
@@ -761,7 +811,8 @@ type scepProfilesResource struct {
 }
 
 type scepProfilesRsModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
 
 	// Input.
 	Type types.String `tfsdk:"type"`
@@ -838,6 +889,9 @@ func (r *scepProfilesResource) Schema(_ context.Context, _ resource.SchemaReques
 				Validators: []validator.String{
 					stringvalidator.OneOf("cloud", "container"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 
 			"algorithm": rsschema.SingleNestedAttribute{
@@ -968,6 +1022,7 @@ func (r *scepProfilesResource) Schema(_ context.Context, _ resource.SchemaReques
 								MarkdownDescription: "The `password` parameter. String length must be between 0 and 255.",
 								Optional:            true,
 								Computed:            true,
+								Sensitive:           true,
 								PlanModifiers: []planmodifier.String{
 									DefaultString(""),
 								},
@@ -994,6 +1049,7 @@ func (r *scepProfilesResource) Schema(_ context.Context, _ resource.SchemaReques
 						MarkdownDescription: "The `fixed` parameter. String length must be between 0 and 1024.",
 						Optional:            true,
 						Computed:            true,
+						Sensitive:           true,
 						PlanModifiers: []planmodifier.String{
 							DefaultString(""),
 						},
@@ -1057,6 +1113,16 @@ func (r *scepProfilesResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_scep_profiles"),
+		},
+	}
+}
+
+// ConfigValidators returns the checks for mutually exclusive attributes.
+func (r *scepProfilesResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		ExactlyOneOfNested(path.MatchRoot("scep_challenge"), "dynamic_value", "fixed", "none"),
 	}
 }
 
@@ -1077,6 +1143,9 @@ func (r *scepProfilesResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_scep_profiles", "create", state.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource create", map[string]any{
 		"terraform_provider_function": "Create",
@@ -1139,7 +1208,7 @@ func (r *scepProfilesResource) Create(ctx context.Context, req resource.CreateRe
 	// Perform the operation.
 	ans, err := svc.Create(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in create", err.Error())
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
 		return
 	}
 
@@ -1214,6 +1283,13 @@ func (r *scepProfilesResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	var state scepProfilesRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_scep_profiles", "read", state.Timeouts)
+	defer cancel()
 
 	// Basic logging.
 	tflog.Info(ctx, "performing resource read", map[string]any{
@@ -1235,7 +1311,7 @@ func (r *scepProfilesResource) Read(ctx context.Context, req resource.ReadReques
 		if IsObjectNotFound(err) {
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error reading config", err.Error())
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
 		}
 		return
 	}
@@ -1306,6 +1382,9 @@ func (r *scepProfilesResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_scep_profiles", "update", plan.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource update", map[string]any{
 		"terraform_provider_function": "Update",
@@ -1368,11 +1447,12 @@ func (r *scepProfilesResource) Update(ctx context.Context, req resource.UpdateRe
 	// Perform the operation.
 	ans, err := svc.Update(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in update", err.Error())
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
 		return
 	}
 
 	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
 	var var6 *scepProfilesRsModelAlgorithmObject
 	if ans.Algorithm != nil {
 		var6 = &scepProfilesRsModelAlgorithmObject{}
@@ -1430,6 +1510,16 @@ func (r *scepProfilesResource) Delete(ctx context.Context, req resource.DeleteRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_scep_profiles", "delete", timeouts)
+	defer cancel()
+
 	id := idType.ValueString()
 	tokens := strings.Split(id, IdSeparator)
 	if len(tokens) != 2 {
@@ -1452,10 +1542,59 @@ func (r *scepProfilesResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	// Perform the operation.
 	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
-		resp.Diagnostics.AddError("Error in delete", err.Error())
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
 	}
 }
 
+// ImportState imports by either the resource ID or by location params and name,
+// such as `type=...,folder=...,name=...`.
 func (r *scepProfilesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	params, ok, err := ParseImportId(req.ID, "type", "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_scep_profiles",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := xlSkOUa.NewClient(r.client)
+	input := xlSkOUa.ListInput{
+		Folder: params["folder"],
+		Name:   api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["type"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -74,3 +75,82 @@ func EncodeInt64Slice(v []int64) []types.Int64 {
 
 	return ans
 }
+
+// DecodeStringList is like DecodeStringSlice, but for a list of strings that
+// may be null or unknown, such as an optional and computed attribute.
+func DecodeStringList(v types.List) []string {
+	if v.IsNull() || v.IsUnknown() || len(v.Elements()) == 0 {
+		return nil
+	}
+
+	ans := make([]string, 0, len(v.Elements()))
+	for _, x := range v.Elements() {
+		if s, ok := x.(types.String); ok {
+			ans = append(ans, s.ValueString())
+		}
+	}
+
+	return ans
+}
+
+// EncodeStringList is like EncodeStringSlice, but returns a list of strings,
+// which is null if v is empty.
+func EncodeStringList(v []string) types.List {
+	if len(v) == 0 {
+		return types.ListNull(types.StringType)
+	}
+
+	ans := make([]attr.Value, 0, len(v))
+	for _, x := range v {
+		ans = append(ans, types.StringValue(x))
+	}
+
+	return types.ListValueMust(types.StringType, ans)
+}
+
+// EncodeStringSet is like EncodeStringSlice, but drops duplicate values, as
+// set attributes can't hold them.
+func EncodeStringSet(v []string) []types.String {
+	if len(v) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]struct{}, len(v))
+	ans := make([]types.String, 0, len(v))
+	for _, x := range v {
+		if _, ok := seen[x]; ok {
+			continue
+		}
+		seen[x] = struct{}{}
+		ans = append(ans, types.StringValue(x))
+	}
+
+	return ans
+}
+
+// StringSetChanges returns the values that are in b but not in a, and the
+// values that are in a but not in b.
+func StringSetChanges(a, b []types.String) ([]string, []string) {
+	inA := make(map[string]struct{}, len(a))
+	for _, x := range a {
+		inA[x.ValueString()] = struct{}{}
+	}
+	inB := make(map[string]struct{}, len(b))
+	for _, x := range b {
+		inB[x.ValueString()] = struct{}{}
+	}
+
+	var added, removed []string
+	for _, x := range b {
+		if _, ok := inA[x.ValueString()]; !ok {
+			added = append(added, x.ValueString())
+		}
+	}
+	for _, x := range a {
+		if _, ok := inB[x.ValueString()]; !ok {
+			removed = append(removed, x.ValueString())
+		}
+	}
+
+	return added, removed
+}
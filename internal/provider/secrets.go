@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Some secrets are write-only: the API accepts the plaintext value, but only
+// ever returns an encrypted or hashed form of it.  Saving what the API returns
+// to state would result in a perpetual diff, so instead the plaintext value is
+// kept in state along with a hash of the value the API returned.  As long as
+// the API keeps returning the same value, the secret is assumed unchanged.
+//
+// Resources with write-only secrets have a `secret_hashes` attribute to hold
+// the hashes and a `secret_version` attribute that forces the secrets to be
+// sent again when changed.  The secrets themselves are collected into a map of
+// pointers to the model's fields, keyed by their location in the model.
+
+// HashSecret returns the hash saved to state for the given value returned by
+// the API.
+func HashSecret(v string) string {
+	sum := sha256.Sum256([]byte(v))
+	return hex.EncodeToString(sum[:])
+}
+
+// SaveSecrets is invoked after a create or update, with the state holding the
+// values returned by the API.  The returned values are hashed, then replaced
+// with the plaintext values from the plan.
+func SaveSecrets(state, plan map[string]*types.String) map[string]types.String {
+	if len(state) == 0 {
+		return nil
+	}
+
+	hashes := make(map[string]types.String, len(state))
+	for key, value := range state {
+		hashes[key] = types.StringValue(HashSecret(value.ValueString()))
+		if x, ok := plan[key]; ok {
+			*value = *x
+		}
+	}
+
+	return hashes
+}
+
+// RefreshSecrets is invoked after a read, with the state holding the values
+// returned by the API.  Secrets that still match the hash in the prior state
+// keep the prior plaintext value.  Secrets that were changed outside of
+// Terraform are left as returned by the API so that the change shows up in
+// the plan.
+func RefreshSecrets(state, prior map[string]*types.String, priorHashes map[string]types.String) map[string]types.String {
+	if len(state) == 0 {
+		return nil
+	}
+
+	hashes := make(map[string]types.String, len(state))
+	for key, value := range state {
+		hash := HashSecret(value.ValueString())
+		hashes[key] = types.StringValue(hash)
+		if x, ok := prior[key]; ok && priorHashes[key].ValueString() == hash {
+			*value = *x
+		}
+	}
+
+	return hashes
+}
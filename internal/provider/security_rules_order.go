@@ -0,0 +1,450 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/paloaltonetworks/sase-go"
+	"github.com/paloaltonetworks/sase-go/api"
+	mPRFtcU "github.com/paloaltonetworks/sase-go/netsec/service/v1/securityrules"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rsschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Resource.
+var (
+	_ resource.Resource                     = &securityRulesOrderResource{}
+	_ resource.ResourceWithConfigure        = &securityRulesOrderResource{}
+	_ resource.ResourceWithConfigValidators = &securityRulesOrderResource{}
+)
+
+func NewSecurityRulesOrderResource() resource.Resource {
+	return &securityRulesOrderResource{}
+}
+
+type securityRulesOrderResource struct {
+	client *sase.Client
+}
+
+type securityRulesOrderRsModel struct {
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
+
+	// Input.
+	Folder           types.String   `tfsdk:"folder"`
+	Position         types.String   `tfsdk:"position"`
+	Rules            []types.String `tfsdk:"rules"`
+	RelativePosition types.String   `tfsdk:"relative_position"`
+	TargetRule       types.String   `tfsdk:"target_rule"`
+
+	// Output.
+	RuleIds []types.String `tfsdk:"rule_ids"`
+}
+
+// Metadata returns the data source type name.
+func (r *securityRulesOrderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security_rules_order"
+}
+
+// Schema defines the schema for this listing data source.
+func (r *securityRulesOrderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rsschema.Schema{
+		Description: "Enforces the order of security rules within a rulebase. Only the given rules are moved, and reordering them outside of Terraform is detected as drift. Deleting this resource leaves the rules where they are.",
+
+		Attributes: map[string]rsschema.Attribute{
+			"id": rsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Input.
+			"folder": rsschema.StringAttribute{
+				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"position": rsschema.StringAttribute{
+				Description:         "The position of a security rule. Value must be one of: `\"pre\"`, `\"post\"`.",
+				MarkdownDescription: "The position of a security rule. Value must be one of: `\"pre\"`, `\"post\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("pre", "post"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rules": rsschema.ListAttribute{
+				Description:         "The object IDs or names of the rules, in the order that they should be in. The rules are kept next to each other in the rulebase.",
+				MarkdownDescription: "The object IDs or names of the rules, in the order that they should be in. The rules are kept next to each other in the rulebase.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"relative_position": rsschema.StringAttribute{
+				Description:         "Where the rules are placed within the rulebase. If unset, only the order of the rules among themselves is enforced. Value must be one of: `\"top\"`, `\"bottom\"`, `\"before\"`, `\"after\"`.",
+				MarkdownDescription: "Where the rules are placed within the rulebase. If unset, only the order of the rules among themselves is enforced. Value must be one of: `\"top\"`, `\"bottom\"`, `\"before\"`, `\"after\"`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(RuleDestinations...),
+				},
+			},
+			"target_rule": rsschema.StringAttribute{
+				Description:         "The object ID or name of the rule to place the rules before or after. Required if `relative_position` is `\"before\"` or `\"after\"`.",
+				MarkdownDescription: "The object ID or name of the rule to place the rules before or after. Required if `relative_position` is `\"before\"` or `\"after\"`.",
+				Optional:            true,
+			},
+
+			// Output.
+			"rule_ids": rsschema.ListAttribute{
+				Description:         "The object IDs of the rules, in order.",
+				MarkdownDescription: "The object IDs of the rules, in order.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_security_rules_order"),
+		},
+	}
+}
+
+// ConfigValidators returns the checks for the rule move attributes.
+func (r *securityRulesOrderResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		RuleMoveValidator(path.Root("relative_position"), path.Root("target_rule")),
+	}
+}
+
+// Configure prepares the struct.
+func (r *securityRulesOrderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*sase.Client)
+}
+
+// Create resource.
+func (r *securityRulesOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state securityRulesOrderRsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_security_rules_order", "create", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource create", map[string]any{
+		"terraform_provider_function": "Create",
+		"resource_name":               "sase_security_rules_order",
+		"folder":                      state.Folder.ValueString(),
+		"position":                    state.Position.ValueString(),
+		"rules":                       DecodeStringSlice(state.Rules),
+		"relative_position":           state.RelativePosition.ValueString(),
+	})
+
+	// Perform the operation.
+	resp.Diagnostics.Append(r.enforce(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Store the answer to state.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(state.Folder.ValueString())
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(state.Position.ValueString())
+	state.Id = types.StringValue(idBuilder.String())
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read resource.
+func (r *securityRulesOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state securityRulesOrderRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_security_rules_order", "read", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource read", map[string]any{
+		"terraform_provider_function": "Read",
+		"resource_name":               "sase_security_rules_order",
+		"folder":                      state.Folder.ValueString(),
+		"position":                    state.Position.ValueString(),
+	})
+
+	// Perform the operation.
+	all, names, err := securityRulebase(ctx, r.client, state.Folder.ValueString(), state.Position.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
+		return
+	}
+
+	// Report the rules in the order that they are actually in, keeping the
+	// ID or name that each rule was given as.  Rules that no longer exist
+	// are dropped.
+	given := make(map[string]string, len(state.RuleIds))
+	for i := range state.RuleIds {
+		if i < len(state.Rules) {
+			given[state.RuleIds[i].ValueString()] = state.Rules[i].ValueString()
+		}
+	}
+	var ids, rules []string
+	for _, id := range all {
+		if x, ok := given[id]; ok {
+			ids = append(ids, id)
+			rules = append(rules, x)
+		}
+	}
+	state.Rules = EncodeStringSlice(rules)
+	state.RuleIds = EncodeStringSlice(ids)
+
+	// Report where the rules actually are if they are no longer where they
+	// were placed.  If that alone would not differ from the config, such as
+	// when another rule was moved in between the rules, the position is
+	// changed so that the next apply enforces the order again.
+	if len(ids) != 0 {
+		target := names[state.TargetRule.ValueString()]
+		if target == "" {
+			target = state.TargetRule.ValueString()
+		}
+		if !rulesInOrder(all, ids, state.RelativePosition.ValueString(), target) {
+			rp, tr := rulesPosition(all, ids)
+			if rp.Equal(state.RelativePosition) && tr.Equal(state.TargetRule) {
+				rp, tr = types.StringNull(), types.StringNull()
+			}
+			state.RelativePosition, state.TargetRule = rp, tr
+		}
+	}
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update resource.
+func (r *securityRulesOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan securityRulesOrderRsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_security_rules_order", "update", plan.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource update", map[string]any{
+		"terraform_provider_function": "Update",
+		"resource_name":               "sase_security_rules_order",
+		"folder":                      plan.Folder.ValueString(),
+		"position":                    plan.Position.ValueString(),
+		"rules":                       DecodeStringSlice(plan.Rules),
+		"relative_position":           plan.RelativePosition.ValueString(),
+	})
+
+	// Perform the operation.
+	resp.Diagnostics.Append(r.enforce(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete resource.
+func (r *securityRulesOrderResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Rules can't be "unmoved", so just remove the order from state.
+	tflog.Info(ctx, "performing resource delete", map[string]any{
+		"terraform_provider_function": "Delete",
+		"resource_name":               "sase_security_rules_order",
+	})
+}
+
+// enforce moves the rules into the configured order and sets rule_ids.
+func (r *securityRulesOrderResource) enforce(ctx context.Context, m *securityRulesOrderRsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	folder, position := m.Folder.ValueString(), m.Position.ValueString()
+
+	all, names, err := securityRulebase(ctx, r.client, folder, position)
+	if err != nil {
+		diags.AddError("Error getting listing", OperationError(ctx, err))
+		return diags
+	}
+
+	// Resolve the rules and the target rule to object IDs.
+	resolve := func(p path.Path, v string) string {
+		if id, ok := names[v]; ok {
+			return id
+		}
+		if indexOf(all, v) >= 0 {
+			return v
+		}
+		diags.AddAttributeError(p, "Invalid Reference", fmt.Sprintf("The security rule %q does not exist in folder %q at position %q.", v, folder, position))
+		return ""
+	}
+	ids := make([]string, 0, len(m.Rules))
+	for i, x := range m.Rules {
+		ids = append(ids, resolve(path.Root("rules").AtListIndex(i), x.ValueString()))
+	}
+	var target string
+	if !m.TargetRule.IsNull() {
+		target = resolve(path.Root("target_rule"), m.TargetRule.ValueString())
+		if target != "" && indexOf(ids, target) >= 0 {
+			diags.AddAttributeError(path.Root("target_rule"), "Invalid Attribute Combination", "target_rule can't be one of the rules being ordered.")
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	// Move the rules, unless they are already in place.
+	if !rulesInOrder(all, ids, m.RelativePosition.ValueString(), target) {
+		svc := mPRFtcU.NewClient(r.client)
+		for i, id := range ids {
+			moveInput := mPRFtcU.MoveInput{
+				ObjectId: id,
+				Rulebase: position,
+			}
+			switch {
+			case i > 0:
+				moveInput.Destination = "after"
+				moveInput.DestinationRule = ids[i-1]
+			case !m.RelativePosition.IsNull():
+				moveInput.Destination = m.RelativePosition.ValueString()
+				moveInput.DestinationRule = target
+			default:
+				continue
+			}
+
+			tflog.Debug(ctx, "moving security rule", map[string]any{
+				"object_id":        moveInput.ObjectId,
+				"destination":      moveInput.Destination,
+				"destination_rule": moveInput.DestinationRule,
+			})
+			if err = svc.Move(ctx, moveInput); err != nil {
+				diags.AddError("Error moving rule", OperationError(ctx, err))
+				return diags
+			}
+		}
+	}
+
+	m.RuleIds = EncodeStringSlice(ids)
+	return diags
+}
+
+// securityRulebase returns the object IDs of the rules in a rulebase, in
+// rulebase order, along with a map of rule names to object IDs.
+func securityRulebase(ctx context.Context, client *sase.Client, folder, position string) ([]string, map[string]string, error) {
+	svc := mPRFtcU.NewClient(client)
+	input := mPRFtcU.ListInput{
+		Position: position,
+		Folder:   folder,
+		Limit:    api.Int(DefaultPageSize),
+	}
+
+	var ids []string
+	names := make(map[string]string)
+	for {
+		ans, err := svc.List(ctx, input)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, x := range ans.Data {
+			ids = append(ids, x.ObjectId)
+			names[x.Name] = x.ObjectId
+		}
+		if len(ans.Data) == 0 || int64(len(ids)) >= ans.Total {
+			break
+		}
+		input.Offset = api.Int(int64(len(ids)))
+	}
+
+	return ids, names, nil
+}
+
+// rulesInOrder returns if the rules given by ids are next to each other in
+// all, in order, and at the given destination.  An empty destination only
+// checks the order of the rules.
+func rulesInOrder(all, ids []string, destination, target string) bool {
+	first := indexOf(all, ids[0])
+	if first < 0 || first+len(ids) > len(all) {
+		return false
+	}
+	for i, id := range ids {
+		if all[first+i] != id {
+			return false
+		}
+	}
+	last := first + len(ids) - 1
+
+	switch destination {
+	case "top":
+		return first == 0
+	case "bottom":
+		return last == len(all)-1
+	case "before":
+		return last+1 < len(all) && all[last+1] == target
+	case "after":
+		return first > 0 && all[first-1] == target
+	}
+
+	return true
+}
+
+// rulesPosition returns the relative position and target rule that describe
+// where the first of the rules given by ids is in all.
+func rulesPosition(all, ids []string) (types.String, types.String) {
+	first, last := indexOf(all, ids[0]), indexOf(all, ids[len(ids)-1])
+	switch {
+	case first == 0:
+		return types.StringValue("top"), types.StringNull()
+	case last == len(all)-1 && last-first == len(ids)-1:
+		return types.StringValue("bottom"), types.StringNull()
+	}
+
+	return types.StringValue("after"), types.StringValue(all[first-1])
+}
+
+func indexOf(v []string, s string) int {
+	for i := range v {
+		if v[i] == s {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRulesInOrder(t *testing.T) {
+	cases := []struct {
+		name        string
+		all         string
+		ids         string
+		destination string
+		target      string
+		want        bool
+	}{
+		{"top", "x y a b", "x y", "top", "", true},
+		{"not at top", "a x y b", "x y", "top", "", false},
+		{"bottom", "a b x y", "x y", "bottom", "", true},
+		{"not at bottom", "x y a", "x y", "bottom", "", false},
+		{"before", "a x y b", "x y", "before", "b", true},
+		{"before other rule", "a x y b", "x y", "before", "a", false},
+		{"before at bottom", "a x y", "x y", "before", "a", false},
+		{"after", "a x y b", "x y", "after", "a", true},
+		{"after other rule", "a x y b", "x y", "after", "b", false},
+		{"after at top", "x y a", "x y", "after", "a", false},
+		{"no anchor", "a x y b", "x y", "", "", true},
+		{"no anchor out of order", "a y x b", "x y", "", "", false},
+		{"interleaved", "x a y", "x y", "", "", false},
+		{"interleaved at top", "x a y", "x y", "top", "", false},
+		{"deleted target before", "a x y b", "x y", "before", "gone", false},
+		{"deleted target after", "a x y b", "x y", "after", "gone", false},
+		{"deleted rule", "a x b", "x y", "", "", false},
+		{"deleted first rule", "a y b", "x y", "", "", false},
+	}
+
+	for _, c := range cases {
+		got := rulesInOrder(strings.Fields(c.all), strings.Fields(c.ids), c.destination, c.target)
+		if got != c.want {
+			t.Errorf("%s: rulesInOrder(%q, %q, %q, %q) is %t, not %t", c.name, c.all, c.ids, c.destination, c.target, got, c.want)
+		}
+	}
+}
+
+func TestRulesPosition(t *testing.T) {
+	cases := []struct {
+		name     string
+		all      string
+		ids      string
+		position types.String
+		target   types.String
+	}{
+		{"top", "x y a b", "x y", types.StringValue("top"), types.StringNull()},
+		{"bottom", "a b x y", "x y", types.StringValue("bottom"), types.StringNull()},
+		{"after", "a x y b", "x y", types.StringValue("after"), types.StringValue("a")},
+		{"interleaved at top", "x a y", "x y", types.StringValue("top"), types.StringNull()},
+		{"interleaved at bottom", "a x b y", "x y", types.StringValue("after"), types.StringValue("a")},
+		{"after deleted target", "b x y c", "x y", types.StringValue("after"), types.StringValue("b")},
+	}
+
+	for _, c := range cases {
+		position, target := rulesPosition(strings.Fields(c.all), strings.Fields(c.ids))
+		if !position.Equal(c.position) || !target.Equal(c.target) {
+			t.Errorf("%s: rulesPosition(%q, %q) is %s %s, not %s %s", c.name, c.all, c.ids, position, target, c.position, c.target)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rsschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// secretWords are the words in an attribute name that suggest that the
+// attribute holds a secret.
+var secretWords = []string{"password", "secret", "key", "passphrase", "psk", "token"}
+
+// notSecret are attribute names that contain a secret word but don't hold
+// a secret.
+var notSecret = map[string]bool{
+	"public_key":               true,
+	"secret_hashes":            true,
+	"secret_version":           true,
+	"use_for_key_encipherment": true,
+}
+
+func looksSecret(name string) bool {
+	if notSecret[name] {
+		return false
+	}
+
+	for _, part := range strings.Split(name, "_") {
+		for _, word := range secretWords {
+			if part == word {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func TestSecretAttributesAreSensitive(t *testing.T) {
+	ctx := context.Background()
+	p := &SaseProvider{}
+
+	for _, fn := range p.Resources(ctx) {
+		r := fn()
+		var meta resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "sase"}, &meta)
+		var resp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &resp)
+		checkRsAttributes(t, meta.TypeName, resp.Schema.Attributes)
+		checkRsBlocks(t, meta.TypeName, resp.Schema.Blocks)
+	}
+
+	for _, fn := range p.DataSources(ctx) {
+		d := fn()
+		var meta datasource.MetadataResponse
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "sase"}, &meta)
+		var resp datasource.SchemaResponse
+		d.Schema(ctx, datasource.SchemaRequest{}, &resp)
+		checkDsAttributes(t, meta.TypeName, resp.Schema.Attributes)
+		checkDsBlocks(t, meta.TypeName, resp.Schema.Blocks)
+	}
+}
+
+// Nested attributes are only containers, so just their children are checked.
+func checkRsAttributes(t *testing.T, prefix string, attrs map[string]rsschema.Attribute) {
+	t.Helper()
+
+	for name, attr := range attrs {
+		loc := prefix + "." + name
+		switch x := attr.(type) {
+		case rsschema.SingleNestedAttribute:
+			checkRsAttributes(t, loc, x.Attributes)
+		case rsschema.ListNestedAttribute:
+			checkRsAttributes(t, loc, x.NestedObject.Attributes)
+		case rsschema.SetNestedAttribute:
+			checkRsAttributes(t, loc, x.NestedObject.Attributes)
+		case rsschema.MapNestedAttribute:
+			checkRsAttributes(t, loc, x.NestedObject.Attributes)
+		default:
+			if looksSecret(name) && !attr.IsSensitive() {
+				t.Errorf("%s looks like a secret but is not marked sensitive", loc)
+			}
+		}
+	}
+}
+
+func checkRsBlocks(t *testing.T, prefix string, blocks map[string]rsschema.Block) {
+	t.Helper()
+
+	for name, block := range blocks {
+		loc := prefix + "." + name
+		switch x := block.(type) {
+		case rsschema.SingleNestedBlock:
+			checkRsAttributes(t, loc, x.Attributes)
+			checkRsBlocks(t, loc, x.Blocks)
+		case rsschema.ListNestedBlock:
+			checkRsAttributes(t, loc, x.NestedObject.Attributes)
+			checkRsBlocks(t, loc, x.NestedObject.Blocks)
+		case rsschema.SetNestedBlock:
+			checkRsAttributes(t, loc, x.NestedObject.Attributes)
+			checkRsBlocks(t, loc, x.NestedObject.Blocks)
+		}
+	}
+}
+
+func checkDsAttributes(t *testing.T, prefix string, attrs map[string]dsschema.Attribute) {
+	t.Helper()
+
+	for name, attr := range attrs {
+		loc := prefix + "." + name
+		switch x := attr.(type) {
+		case dsschema.SingleNestedAttribute:
+			checkDsAttributes(t, loc, x.Attributes)
+		case dsschema.ListNestedAttribute:
+			checkDsAttributes(t, loc, x.NestedObject.Attributes)
+		case dsschema.SetNestedAttribute:
+			checkDsAttributes(t, loc, x.NestedObject.Attributes)
+		case dsschema.MapNestedAttribute:
+			checkDsAttributes(t, loc, x.NestedObject.Attributes)
+		default:
+			if looksSecret(name) && !attr.IsSensitive() {
+				t.Errorf("%s looks like a secret but is not marked sensitive", loc)
+			}
+		}
+	}
+}
+
+func checkDsBlocks(t *testing.T, prefix string, blocks map[string]dsschema.Block) {
+	t.Helper()
+
+	for name, block := range blocks {
+		loc := prefix + "." + name
+		switch x := block.(type) {
+		case dsschema.SingleNestedBlock:
+			checkDsAttributes(t, loc, x.Attributes)
+			checkDsBlocks(t, loc, x.Blocks)
+		case dsschema.ListNestedBlock:
+			checkDsAttributes(t, loc, x.NestedObject.Attributes)
+			checkDsBlocks(t, loc, x.NestedObject.Blocks)
+		case dsschema.SetNestedBlock:
+			checkDsAttributes(t, loc, x.NestedObject.Attributes)
+			checkDsBlocks(t, loc, x.NestedObject.Blocks)
+		}
+	}
+}
@@ -7,11 +7,19 @@ import (
 
 	"github.com/paloaltonetworks/sase-go"
 	"github.com/paloaltonetworks/sase-go/api"
+	wTqHvmZ "github.com/paloaltonetworks/sase-go/netsec/schema/service/connection/groups"
 	rCnEjTJ "github.com/paloaltonetworks/sase-go/netsec/service/v1/serviceconnectiongroup"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rsschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -35,10 +43,13 @@ type serviceConnectionGroupsListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Name   types.String `tfsdk:"name"`
-	Folder types.String `tfsdk:"folder"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Name     types.String `tfsdk:"name"`
+	Folder   types.String `tfsdk:"folder"`
 
 	// Output.
 	Data []serviceConnectionGroupsListDsModelConfig `tfsdk:"data"`
@@ -84,6 +95,27 @@ func (d *serviceConnectionGroupsListDataSource) Schema(_ context.Context, _ data
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"name": dsschema.StringAttribute{
 				Description:         "The name of the entry.",
 				MarkdownDescription: "The name of the entry.",
@@ -182,12 +214,32 @@ func (d *serviceConnectionGroupsListDataSource) Read(ctx context.Context, req da
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -234,3 +286,663 @@ func (d *serviceConnectionGroupsListDataSource) Read(ctx context.Context, req da
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// Data source.
+var (
+	_ datasource.DataSource              = &serviceConnectionGroupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &serviceConnectionGroupsDataSource{}
+)
+
+func NewServiceConnectionGroupsDataSource() datasource.DataSource {
+	return &serviceConnectionGroupsDataSource{}
+}
+
+type serviceConnectionGroupsDataSource struct {
+	client *sase.Client
+}
+
+type serviceConnectionGroupsDsModel struct {
+	Id types.String `tfsdk:"id"`
+
+	// Input.
+	ObjectId types.String `tfsdk:"object_id"`
+	Folder   types.String `tfsdk:"folder"`
+
+	// Output.
+	// Ref: #/components/schemas/service-connection-groups
+	DisableSnat types.Bool `tfsdk:"disable_snat"`
+	// input omit: ObjectId
+	Name    types.String   `tfsdk:"name"`
+	PbfOnly types.Bool     `tfsdk:"pbf_only"`
+	Target  []types.String `tfsdk:"target"`
+}
+
+// Metadata returns the data source type name.
+func (d *serviceConnectionGroupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_connection_groups"
+}
+
+// Schema defines the schema for this listing data source.
+func (d *serviceConnectionGroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Retrieves config for a specific item.",
+
+		Attributes: map[string]dsschema.Attribute{
+			"id": dsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+			},
+
+			// Input.
+			"object_id": dsschema.StringAttribute{
+				Description:         "The uuid of the resource.",
+				MarkdownDescription: "The uuid of the resource.",
+				Required:            true,
+			},
+			"folder": dsschema.StringAttribute{
+				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
+				},
+			},
+
+			// Output.
+			"disable_snat": dsschema.BoolAttribute{
+				Description:         "The `disable_snat` parameter.",
+				MarkdownDescription: "The `disable_snat` parameter.",
+				Computed:            true,
+			},
+			"name": dsschema.StringAttribute{
+				Description:         "The `name` parameter.",
+				MarkdownDescription: "The `name` parameter.",
+				Computed:            true,
+			},
+			"pbf_only": dsschema.BoolAttribute{
+				Description:         "The `pbf_only` parameter.",
+				MarkdownDescription: "The `pbf_only` parameter.",
+				Computed:            true,
+			},
+			"target": dsschema.ListAttribute{
+				Description:         "The `target` parameter.",
+				MarkdownDescription: "The `target` parameter.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+// Configure prepares the struct.
+func (d *serviceConnectionGroupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*sase.Client)
+}
+
+func (d *serviceConnectionGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state serviceConnectionGroupsDsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing data source singleton retrieval", map[string]any{
+		"terraform_provider_function": "Read",
+		"data_source_name":            "sase_service_connection_groups",
+		"object_id":                   state.ObjectId.ValueString(),
+		"folder":                      state.Folder.ValueString(),
+	})
+
+	// Prepare to run the command.
+	svc := rCnEjTJ.NewClient(d.client)
+	input := rCnEjTJ.ReadInput{
+		ObjectId: state.ObjectId.ValueString(),
+		Folder:   state.Folder.ValueString(),
+	}
+
+	// Perform the operation.
+	ans, err := svc.Read(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting singleton", err.Error())
+		return
+	}
+
+	// Store the answer to state.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(input.ObjectId)
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(input.Folder)
+	state.Id = types.StringValue(idBuilder.String())
+	state.DisableSnat = types.BoolValue(ans.DisableSnat)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.Name = types.StringValue(ans.Name)
+	state.PbfOnly = types.BoolValue(ans.PbfOnly)
+	state.Target = EncodeStringSlice(ans.Target)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Resource.
+var (
+	_ resource.Resource                = &serviceConnectionGroupsResource{}
+	_ resource.ResourceWithConfigure   = &serviceConnectionGroupsResource{}
+	_ resource.ResourceWithImportState = &serviceConnectionGroupsResource{}
+)
+
+func NewServiceConnectionGroupsResource() resource.Resource {
+	return &serviceConnectionGroupsResource{}
+}
+
+type serviceConnectionGroupsResource struct {
+	client *sase.Client
+}
+
+type serviceConnectionGroupsRsModel struct {
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
+
+	// Input.
+	Folder types.String `tfsdk:"folder"`
+
+	// Request body input.
+	// Ref: #/components/schemas/service-connection-groups
+	DisableSnat types.Bool     `tfsdk:"disable_snat"`
+	ObjectId    types.String   `tfsdk:"object_id"`
+	Name        types.String   `tfsdk:"name"`
+	PbfOnly     types.Bool     `tfsdk:"pbf_only"`
+	Target      []types.String `tfsdk:"target"`
+}
+
+// Metadata returns the data source type name.
+func (r *serviceConnectionGroupsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_connection_groups"
+}
+
+// Schema defines the schema for this listing data source.
+func (r *serviceConnectionGroupsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rsschema.Schema{
+		Description: "Retrieves config for a specific item.",
+
+		Attributes: map[string]rsschema.Attribute{
+			"id": rsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Input.
+			"folder": rsschema.StringAttribute{
+				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"disable_snat": rsschema.BoolAttribute{
+				Description:         "The `disable_snat` parameter.",
+				MarkdownDescription: "The `disable_snat` parameter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					DefaultBool(false),
+				},
+			},
+			"object_id": rsschema.StringAttribute{
+				Description:         "The `object_id` parameter.",
+				MarkdownDescription: "The `object_id` parameter.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": rsschema.StringAttribute{
+				Description:         "The `name` parameter. String length must be at most 63.",
+				MarkdownDescription: "The `name` parameter. String length must be at most 63.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(63),
+				},
+			},
+			"pbf_only": rsschema.BoolAttribute{
+				Description:         "The `pbf_only` parameter.",
+				MarkdownDescription: "The `pbf_only` parameter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					DefaultBool(false),
+				},
+			},
+			"target": rsschema.ListAttribute{
+				Description:         "The `target` parameter. Must contain at least one service connection, without duplicates. Service connections added to the group outside of this resource are left in place. If a group with this name already exists in the folder, it is adopted and these service connections are added to it.",
+				MarkdownDescription: "The `target` parameter. Must contain at least one service connection, without duplicates. Service connections added to the group outside of this resource are left in place. If a group with this name already exists in the folder, it is adopted and these service connections are added to it.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_service_connection_groups"),
+		},
+	}
+}
+
+// Configure prepares the struct.
+func (r *serviceConnectionGroupsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*sase.Client)
+}
+
+// Create resource
+func (r *serviceConnectionGroupsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state serviceConnectionGroupsRsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_service_connection_groups", "create", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource create", map[string]any{
+		"terraform_provider_function": "Create",
+		"resource_name":               "sase_service_connection_groups",
+		"folder":                      state.Folder.ValueString(),
+	})
+
+	// Prepare to create the config.
+	svc := rCnEjTJ.NewClient(r.client)
+	input := rCnEjTJ.CreateInput{
+		Folder: state.Folder.ValueString(),
+	}
+	var var0 wTqHvmZ.Config
+	var0.DisableSnat = state.DisableSnat.ValueBool()
+	var0.Name = state.Name.ValueString()
+	var0.PbfOnly = state.PbfOnly.ValueBool()
+	var0.Target = DecodeStringSlice(state.Target)
+	input.Config = var0
+
+	// Another resource may have already created the group, in which case it
+	// is adopted and the planned targets are merged into it.
+	list, err := svc.List(ctx, rCnEjTJ.ListInput{
+		Folder: input.Folder,
+		Name:   api.String(input.Config.Name),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", OperationError(ctx, err))
+		return
+	}
+	var cur *wTqHvmZ.Config
+	for i := range list.Data {
+		if list.Data[i].Name == input.Config.Name {
+			cur = &list.Data[i]
+			break
+		}
+	}
+
+	// Perform the operation.
+	var ans wTqHvmZ.Config
+	if cur == nil {
+		ans, err = svc.Create(ctx, input)
+	} else {
+		tflog.Info(ctx, "adopting existing service connection group", map[string]any{
+			"object_id": cur.ObjectId,
+			"targets":   cur.Target,
+		})
+		input.Config.Target = append(input.Config.Target, unmanagedTargets(cur.Target, state.Target)...)
+		ans, err = svc.Update(ctx, rCnEjTJ.UpdateInput{
+			ObjectId: cur.ObjectId,
+			Config:   input.Config,
+		})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(input.Folder)
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(ans.ObjectId)
+	state.Id = types.StringValue(idBuilder.String())
+	state.DisableSnat = types.BoolValue(ans.DisableSnat)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.Name = types.StringValue(ans.Name)
+	state.PbfOnly = types.BoolValue(ans.PbfOnly)
+	state.Target = managedTargets(ans.Target, state.Target)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read resource.
+func (r *serviceConnectionGroupsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var idType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &idType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := idType.ValueString()
+	tokens := strings.Split(id, IdSeparator)
+	if len(tokens) != 2 {
+		resp.Diagnostics.AddError("Error in resource ID format", "Expected 2 tokens")
+		return
+	}
+
+	var state serviceConnectionGroupsRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The target is null after an import.  Which of the group's targets are
+	// managed by this resource is not known until the next apply, so it is
+	// left null until then and only the configured targets are taken.
+	var prior []types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("target"), &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_service_connection_groups", "read", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource read", map[string]any{
+		"terraform_provider_function": "Read",
+		"resource_name":               "sase_service_connection_groups",
+		"locMap":                      map[string]int{"Folder": 0, "ObjectId": 1},
+		"tokens":                      tokens,
+	})
+
+	// Prepare to read the config.
+	svc := rCnEjTJ.NewClient(r.client)
+	input := rCnEjTJ.ReadInput{
+		ObjectId: tokens[1],
+		Folder:   tokens[0],
+	}
+
+	// Perform the operation.
+	ans, err := svc.Read(ctx, input)
+	if err != nil {
+		if IsObjectNotFound(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
+		}
+		return
+	}
+
+	// Store the answer to state.
+	state.Folder = types.StringValue(tokens[0])
+	state.Id = idType
+	state.DisableSnat = types.BoolValue(ans.DisableSnat)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.Name = types.StringValue(ans.Name)
+	state.PbfOnly = types.BoolValue(ans.PbfOnly)
+	if prior != nil {
+		state.Target = managedTargets(ans.Target, prior)
+	}
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update resource.
+func (r *serviceConnectionGroupsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state serviceConnectionGroupsRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_service_connection_groups", "update", plan.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource update", map[string]any{
+		"terraform_provider_function": "Update",
+		"resource_name":               "sase_service_connection_groups",
+		"object_id":                   state.ObjectId.ValueString(),
+	})
+
+	// Prepare to create the config.
+	svc := rCnEjTJ.NewClient(r.client)
+	input := rCnEjTJ.UpdateInput{
+		ObjectId: state.ObjectId.ValueString(),
+	}
+	var var0 wTqHvmZ.Config
+	var0.DisableSnat = plan.DisableSnat.ValueBool()
+	var0.Name = plan.Name.ValueString()
+	var0.PbfOnly = plan.PbfOnly.ValueBool()
+	var0.Target = DecodeStringSlice(plan.Target)
+	input.Config = var0
+
+	// Keep the targets that were added outside of this resource.
+	cur, err := svc.Read(ctx, rCnEjTJ.ReadInput{
+		ObjectId: state.ObjectId.ValueString(),
+		Folder:   state.Folder.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
+		return
+	}
+	input.Config.Target = append(input.Config.Target, unmanagedTargets(cur.Target, state.Target, plan.Target)...)
+
+	// Perform the operation.
+	ans, err := svc.Update(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
+	state.DisableSnat = types.BoolValue(ans.DisableSnat)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.Name = types.StringValue(ans.Name)
+	state.PbfOnly = types.BoolValue(ans.PbfOnly)
+	state.Target = managedTargets(ans.Target, plan.Target)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete resource.
+func (r *serviceConnectionGroupsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var idType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &idType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var target []types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("target"), &target)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_service_connection_groups", "delete", timeouts)
+	defer cancel()
+
+	id := idType.ValueString()
+	tokens := strings.Split(id, IdSeparator)
+	if len(tokens) != 2 {
+		resp.Diagnostics.AddError("Error in resource ID format", "Expected 2 tokens")
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource delete", map[string]any{
+		"terraform_provider_function": "Delete",
+		"resource_name":               "sase_service_connection_groups",
+		"locMap":                      map[string]int{"Folder": 0, "ObjectId": 1},
+		"tokens":                      tokens,
+	})
+
+	svc := rCnEjTJ.NewClient(r.client)
+
+	// Only remove this resource's targets if others are still in the group.
+	cur, err := svc.Read(ctx, rCnEjTJ.ReadInput{
+		ObjectId: tokens[1],
+		Folder:   tokens[0],
+	})
+	if err != nil {
+		if !IsObjectNotFound(err) {
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
+		}
+		return
+	}
+	if others := unmanagedTargets(cur.Target, target); len(others) != 0 {
+		input := rCnEjTJ.UpdateInput{
+			ObjectId: tokens[1],
+			Config: wTqHvmZ.Config{
+				DisableSnat: cur.DisableSnat,
+				Name:        cur.Name,
+				PbfOnly:     cur.PbfOnly,
+				Target:      others,
+			},
+		}
+		if _, err = svc.Update(ctx, input); err != nil && !IsObjectNotFound(err) {
+			resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
+		}
+		return
+	}
+
+	input := rCnEjTJ.DeleteInput{
+		ObjectId: tokens[1],
+	}
+
+	// Perform the operation.
+	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
+	}
+}
+
+// ImportState imports by either the resource ID or by location params and name,
+// such as `folder=...,name=...`.
+func (r *serviceConnectionGroupsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	params, ok, err := ParseImportId(req.ID, "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_service_connection_groups",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := rCnEjTJ.NewClient(r.client)
+	input := rCnEjTJ.ListInput{
+		Folder: params["folder"],
+		Name:   api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
+}
+
+// The targets of a service connection group may be managed by more than one
+// resource, such as from different workspaces, so each resource only manages
+// the service connections in its own `target` and leaves the rest as is.
+
+// managedTargets returns the targets in managed that are in the group, in the
+// order of managed.
+func managedTargets(group []string, managed []types.String) []types.String {
+	ans := make([]types.String, 0, len(managed))
+	for _, x := range managed {
+		for _, y := range group {
+			if x.ValueString() == y {
+				ans = append(ans, x)
+				break
+			}
+		}
+	}
+
+	return ans
+}
+
+// unmanagedTargets returns the targets of the group that are in none of the
+// managed lists.
+func unmanagedTargets(group []string, managed ...[]types.String) []string {
+	var ans []string
+	for _, x := range group {
+		found := false
+		for _, list := range managed {
+			for _, y := range list {
+				if x == y.ValueString() {
+					found = true
+					break
+				}
+			}
+		}
+		if !found {
+			ans = append(ans, x)
+		}
+	}
+
+	return ans
+}
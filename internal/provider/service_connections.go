@@ -7,11 +7,20 @@ import (
 
 	"github.com/paloaltonetworks/sase-go"
 	"github.com/paloaltonetworks/sase-go/api"
+	kRfBnQa "github.com/paloaltonetworks/sase-go/netsec/schema/service/connections"
 	yaiLoaU "github.com/paloaltonetworks/sase-go/netsec/service/v1/serviceconnections"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rsschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -35,10 +44,13 @@ type serviceConnectionsListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Name   types.String `tfsdk:"name"`
-	Folder types.String `tfsdk:"folder"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Name     types.String `tfsdk:"name"`
+	Folder   types.String `tfsdk:"folder"`
 
 	// Output.
 	Data []serviceConnectionsListDsModelConfig `tfsdk:"data"`
@@ -123,6 +135,27 @@ func (d *serviceConnectionsListDataSource) Schema(_ context.Context, _ datasourc
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"name": dsschema.StringAttribute{
 				Description:         "The name of the entry.",
 				MarkdownDescription: "The name of the entry.",
@@ -183,6 +216,7 @@ func (d *serviceConnectionsListDataSource) Schema(_ context.Context, _ datasourc
 									Description:         "The `secret` parameter.",
 									MarkdownDescription: "The `secret` parameter.",
 									Computed:            true,
+									Sensitive:           true,
 								},
 							},
 						},
@@ -260,6 +294,7 @@ func (d *serviceConnectionsListDataSource) Schema(_ context.Context, _ datasourc
 											Description:         "The `secret` parameter.",
 											MarkdownDescription: "The `secret` parameter.",
 											Computed:            true,
+											Sensitive:           true,
 										},
 										"summarize_mobile_user_routes": dsschema.BoolAttribute{
 											Description:         "The `summarize_mobile_user_routes` parameter.",
@@ -364,12 +399,32 @@ func (d *serviceConnectionsListDataSource) Read(ctx context.Context, req datasou
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -459,3 +514,1314 @@ func (d *serviceConnectionsListDataSource) Read(ctx context.Context, req datasou
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// Data source.
+var (
+	_ datasource.DataSource                     = &serviceConnectionsDataSource{}
+	_ datasource.DataSourceWithConfigure        = &serviceConnectionsDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &serviceConnectionsDataSource{}
+)
+
+func NewServiceConnectionsDataSource() datasource.DataSource {
+	return &serviceConnectionsDataSource{}
+}
+
+type serviceConnectionsDataSource struct {
+	client *sase.Client
+}
+
+type serviceConnectionsDsModel struct {
+	Id types.String `tfsdk:"id"`
+
+	// Input.
+	ObjectId types.String `tfsdk:"object_id"`
+	Folder   types.String `tfsdk:"folder"`
+
+	// Output.
+	// Ref: #/components/schemas/service-connections
+	BackupSC types.String                            `tfsdk:"backup_s_c"`
+	BgpPeer  *serviceConnectionsDsModelBgpPeerObject `tfsdk:"bgp_peer"`
+	// input omit: ObjectId
+	IpsecTunnel          types.String                             `tfsdk:"ipsec_tunnel"`
+	Name                 types.String                             `tfsdk:"name"`
+	NatPool              types.String                             `tfsdk:"nat_pool"`
+	NoExportCommunity    types.String                             `tfsdk:"no_export_community"`
+	OnboardingType       types.String                             `tfsdk:"onboarding_type"`
+	Protocol             *serviceConnectionsDsModelProtocolObject `tfsdk:"protocol"`
+	Qos                  *serviceConnectionsDsModelQosObject      `tfsdk:"qos"`
+	Region               types.String                             `tfsdk:"region"`
+	SecondaryIpsecTunnel types.String                             `tfsdk:"secondary_ipsec_tunnel"`
+	SourceNat            types.Bool                               `tfsdk:"source_nat"`
+	Subnets              []types.String                           `tfsdk:"subnets"`
+}
+
+type serviceConnectionsDsModelBgpPeerObject struct {
+	LocalIpAddress   types.String `tfsdk:"local_ip_address"`
+	LocalIpv6Address types.String `tfsdk:"local_ipv6_address"`
+	PeerIpAddress    types.String `tfsdk:"peer_ip_address"`
+	PeerIpv6Address  types.String `tfsdk:"peer_ipv6_address"`
+	SameAsPrimary    types.Bool   `tfsdk:"same_as_primary"`
+	Secret           types.String `tfsdk:"secret"`
+}
+
+type serviceConnectionsDsModelProtocolObject struct {
+	Bgp *serviceConnectionsDsModelBgpObject `tfsdk:"bgp"`
+}
+
+type serviceConnectionsDsModelBgpObject struct {
+	DoNotExportRoutes         types.Bool   `tfsdk:"do_not_export_routes"`
+	Enable                    types.Bool   `tfsdk:"enable"`
+	FastFailover              types.Bool   `tfsdk:"fast_failover"`
+	LocalIpAddress            types.String `tfsdk:"local_ip_address"`
+	OriginateDefaultRoute     types.Bool   `tfsdk:"originate_default_route"`
+	PeerAs                    types.String `tfsdk:"peer_as"`
+	PeerIpAddress             types.String `tfsdk:"peer_ip_address"`
+	Secret                    types.String `tfsdk:"secret"`
+	SummarizeMobileUserRoutes types.Bool   `tfsdk:"summarize_mobile_user_routes"`
+}
+
+type serviceConnectionsDsModelQosObject struct {
+	Enable     types.Bool   `tfsdk:"enable"`
+	QosProfile types.String `tfsdk:"qos_profile"`
+}
+
+// Metadata returns the data source type name.
+func (d *serviceConnectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_connections"
+}
+
+// Schema defines the schema for this listing data source.
+func (d *serviceConnectionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Retrieves config for a specific item.",
+
+		Attributes: map[string]dsschema.Attribute{
+			"id": dsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+			},
+
+			// Input.
+			"object_id": dsschema.StringAttribute{
+				Description:         "The uuid of the resource. Exactly one of `object_id` and `name` must be set.",
+				MarkdownDescription: "The uuid of the resource. Exactly one of `object_id` and `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"folder": dsschema.StringAttribute{
+				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
+				},
+			},
+
+			// Output.
+			"backup_s_c": dsschema.StringAttribute{
+				Description:         "The `backup_s_c` parameter.",
+				MarkdownDescription: "The `backup_s_c` parameter.",
+				Computed:            true,
+			},
+			"bgp_peer": dsschema.SingleNestedAttribute{
+				Description:         "The `bgp_peer` parameter.",
+				MarkdownDescription: "The `bgp_peer` parameter.",
+				Computed:            true,
+				Attributes: map[string]dsschema.Attribute{
+					"local_ip_address": dsschema.StringAttribute{
+						Description:         "The `local_ip_address` parameter.",
+						MarkdownDescription: "The `local_ip_address` parameter.",
+						Computed:            true,
+					},
+					"local_ipv6_address": dsschema.StringAttribute{
+						Description:         "The `local_ipv6_address` parameter.",
+						MarkdownDescription: "The `local_ipv6_address` parameter.",
+						Computed:            true,
+					},
+					"peer_ip_address": dsschema.StringAttribute{
+						Description:         "The `peer_ip_address` parameter.",
+						MarkdownDescription: "The `peer_ip_address` parameter.",
+						Computed:            true,
+					},
+					"peer_ipv6_address": dsschema.StringAttribute{
+						Description:         "The `peer_ipv6_address` parameter.",
+						MarkdownDescription: "The `peer_ipv6_address` parameter.",
+						Computed:            true,
+					},
+					"same_as_primary": dsschema.BoolAttribute{
+						Description:         "The `same_as_primary` parameter.",
+						MarkdownDescription: "The `same_as_primary` parameter.",
+						Computed:            true,
+					},
+					"secret": dsschema.StringAttribute{
+						Description:         "The `secret` parameter.",
+						MarkdownDescription: "The `secret` parameter.",
+						Computed:            true,
+						Sensitive:           true,
+					},
+				},
+			},
+			"ipsec_tunnel": dsschema.StringAttribute{
+				Description:         "The `ipsec_tunnel` parameter.",
+				MarkdownDescription: "The `ipsec_tunnel` parameter.",
+				Computed:            true,
+			},
+			"name": dsschema.StringAttribute{
+				Description:         "The `name` parameter. Exactly one of `object_id` and `name` must be set.",
+				MarkdownDescription: "The `name` parameter. Exactly one of `object_id` and `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"nat_pool": dsschema.StringAttribute{
+				Description:         "The `nat_pool` parameter.",
+				MarkdownDescription: "The `nat_pool` parameter.",
+				Computed:            true,
+			},
+			"no_export_community": dsschema.StringAttribute{
+				Description:         "The `no_export_community` parameter.",
+				MarkdownDescription: "The `no_export_community` parameter.",
+				Computed:            true,
+			},
+			"onboarding_type": dsschema.StringAttribute{
+				Description:         "The `onboarding_type` parameter.",
+				MarkdownDescription: "The `onboarding_type` parameter.",
+				Computed:            true,
+			},
+			"protocol": dsschema.SingleNestedAttribute{
+				Description:         "The `protocol` parameter.",
+				MarkdownDescription: "The `protocol` parameter.",
+				Computed:            true,
+				Attributes: map[string]dsschema.Attribute{
+					"bgp": dsschema.SingleNestedAttribute{
+						Description:         "The `bgp` parameter.",
+						MarkdownDescription: "The `bgp` parameter.",
+						Computed:            true,
+						Attributes: map[string]dsschema.Attribute{
+							"do_not_export_routes": dsschema.BoolAttribute{
+								Description:         "The `do_not_export_routes` parameter.",
+								MarkdownDescription: "The `do_not_export_routes` parameter.",
+								Computed:            true,
+							},
+							"enable": dsschema.BoolAttribute{
+								Description:         "The `enable` parameter.",
+								MarkdownDescription: "The `enable` parameter.",
+								Computed:            true,
+							},
+							"fast_failover": dsschema.BoolAttribute{
+								Description:         "The `fast_failover` parameter.",
+								MarkdownDescription: "The `fast_failover` parameter.",
+								Computed:            true,
+							},
+							"local_ip_address": dsschema.StringAttribute{
+								Description:         "The `local_ip_address` parameter.",
+								MarkdownDescription: "The `local_ip_address` parameter.",
+								Computed:            true,
+							},
+							"originate_default_route": dsschema.BoolAttribute{
+								Description:         "The `originate_default_route` parameter.",
+								MarkdownDescription: "The `originate_default_route` parameter.",
+								Computed:            true,
+							},
+							"peer_as": dsschema.StringAttribute{
+								Description:         "The `peer_as` parameter.",
+								MarkdownDescription: "The `peer_as` parameter.",
+								Computed:            true,
+							},
+							"peer_ip_address": dsschema.StringAttribute{
+								Description:         "The `peer_ip_address` parameter.",
+								MarkdownDescription: "The `peer_ip_address` parameter.",
+								Computed:            true,
+							},
+							"secret": dsschema.StringAttribute{
+								Description:         "The `secret` parameter.",
+								MarkdownDescription: "The `secret` parameter.",
+								Computed:            true,
+								Sensitive:           true,
+							},
+							"summarize_mobile_user_routes": dsschema.BoolAttribute{
+								Description:         "The `summarize_mobile_user_routes` parameter.",
+								MarkdownDescription: "The `summarize_mobile_user_routes` parameter.",
+								Computed:            true,
+							},
+						},
+					},
+				},
+			},
+			"qos": dsschema.SingleNestedAttribute{
+				Description:         "The `qos` parameter.",
+				MarkdownDescription: "The `qos` parameter.",
+				Computed:            true,
+				Attributes: map[string]dsschema.Attribute{
+					"enable": dsschema.BoolAttribute{
+						Description:         "The `enable` parameter.",
+						MarkdownDescription: "The `enable` parameter.",
+						Computed:            true,
+					},
+					"qos_profile": dsschema.StringAttribute{
+						Description:         "The `qos_profile` parameter.",
+						MarkdownDescription: "The `qos_profile` parameter.",
+						Computed:            true,
+					},
+				},
+			},
+			"region": dsschema.StringAttribute{
+				Description:         "The `region` parameter.",
+				MarkdownDescription: "The `region` parameter.",
+				Computed:            true,
+			},
+			"secondary_ipsec_tunnel": dsschema.StringAttribute{
+				Description:         "The `secondary_ipsec_tunnel` parameter.",
+				MarkdownDescription: "The `secondary_ipsec_tunnel` parameter.",
+				Computed:            true,
+			},
+			"source_nat": dsschema.BoolAttribute{
+				Description:         "The `source_nat` parameter.",
+				MarkdownDescription: "The `source_nat` parameter.",
+				Computed:            true,
+			},
+			"subnets": dsschema.ListAttribute{
+				Description:         "The `subnets` parameter.",
+				MarkdownDescription: "The `subnets` parameter.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+// Configure prepares the struct.
+func (d *serviceConnectionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*sase.Client)
+}
+
+// ConfigValidators returns the checks for mutually exclusive attributes.
+func (d *serviceConnectionsDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("object_id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *serviceConnectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state serviceConnectionsDsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing data source singleton retrieval", map[string]any{
+		"terraform_provider_function": "Read",
+		"data_source_name":            "sase_service_connections",
+		"object_id":                   state.ObjectId.ValueString(),
+		"name":                        state.Name.ValueString(),
+		"folder":                      state.Folder.ValueString(),
+	})
+
+	// Prepare to run the command.
+	svc := yaiLoaU.NewClient(d.client)
+	input := yaiLoaU.ReadInput{
+		ObjectId: state.ObjectId.ValueString(),
+		Folder:   state.Folder.ValueString(),
+	}
+
+	// Look up the object ID by name.
+	if state.ObjectId.IsNull() {
+		params := map[string]string{
+			"folder": state.Folder.ValueString(),
+			"name":   state.Name.ValueString(),
+		}
+		list, err := svc.List(ctx, yaiLoaU.ListInput{
+			Folder: params["folder"],
+			Name:   api.String(params["name"]),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error getting listing", err.Error())
+			return
+		}
+		var ids []string
+		for _, x := range list.Data {
+			if x.Name == params["name"] {
+				ids = append(ids, x.ObjectId)
+			}
+		}
+		input.ObjectId, err = ImportObjectId(params, ids)
+		if err != nil {
+			resp.Diagnostics.AddError("Error getting singleton", err.Error())
+			return
+		}
+	}
+
+	// Perform the operation.
+	ans, err := svc.Read(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting singleton", err.Error())
+		return
+	}
+
+	// Store the answer to state.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(input.ObjectId)
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(input.Folder)
+	state.Id = types.StringValue(idBuilder.String())
+	var var0 *serviceConnectionsDsModelBgpPeerObject
+	if ans.BgpPeer != nil {
+		var0 = &serviceConnectionsDsModelBgpPeerObject{}
+		var0.LocalIpAddress = types.StringValue(ans.BgpPeer.LocalIpAddress)
+		var0.LocalIpv6Address = types.StringValue(ans.BgpPeer.LocalIpv6Address)
+		var0.PeerIpAddress = types.StringValue(ans.BgpPeer.PeerIpAddress)
+		var0.PeerIpv6Address = types.StringValue(ans.BgpPeer.PeerIpv6Address)
+		var0.SameAsPrimary = types.BoolValue(ans.BgpPeer.SameAsPrimary)
+		var0.Secret = types.StringValue(ans.BgpPeer.Secret)
+	}
+	var var1 *serviceConnectionsDsModelProtocolObject
+	if ans.Protocol != nil {
+		var1 = &serviceConnectionsDsModelProtocolObject{}
+		var var2 *serviceConnectionsDsModelBgpObject
+		if ans.Protocol.Bgp != nil {
+			var2 = &serviceConnectionsDsModelBgpObject{}
+			var2.DoNotExportRoutes = types.BoolValue(ans.Protocol.Bgp.DoNotExportRoutes)
+			var2.Enable = types.BoolValue(ans.Protocol.Bgp.Enable)
+			var2.FastFailover = types.BoolValue(ans.Protocol.Bgp.FastFailover)
+			var2.LocalIpAddress = types.StringValue(ans.Protocol.Bgp.LocalIpAddress)
+			var2.OriginateDefaultRoute = types.BoolValue(ans.Protocol.Bgp.OriginateDefaultRoute)
+			var2.PeerAs = types.StringValue(ans.Protocol.Bgp.PeerAs)
+			var2.PeerIpAddress = types.StringValue(ans.Protocol.Bgp.PeerIpAddress)
+			var2.Secret = types.StringValue(ans.Protocol.Bgp.Secret)
+			var2.SummarizeMobileUserRoutes = types.BoolValue(ans.Protocol.Bgp.SummarizeMobileUserRoutes)
+		}
+		var1.Bgp = var2
+	}
+	var var3 *serviceConnectionsDsModelQosObject
+	if ans.Qos != nil {
+		var3 = &serviceConnectionsDsModelQosObject{}
+		var3.Enable = types.BoolValue(ans.Qos.Enable)
+		var3.QosProfile = types.StringValue(ans.Qos.QosProfile)
+	}
+	state.BackupSC = types.StringValue(ans.BackupSC)
+	state.BgpPeer = var0
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.IpsecTunnel = types.StringValue(ans.IpsecTunnel)
+	state.Name = types.StringValue(ans.Name)
+	state.NatPool = types.StringValue(ans.NatPool)
+	state.NoExportCommunity = types.StringValue(ans.NoExportCommunity)
+	state.OnboardingType = types.StringValue(ans.OnboardingType)
+	state.Protocol = var1
+	state.Qos = var3
+	state.Region = types.StringValue(ans.Region)
+	state.SecondaryIpsecTunnel = types.StringValue(ans.SecondaryIpsecTunnel)
+	state.SourceNat = types.BoolValue(ans.SourceNat)
+	state.Subnets = EncodeStringSlice(ans.Subnets)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Resource.
+var (
+	_ resource.Resource                = &serviceConnectionsResource{}
+	_ resource.ResourceWithConfigure   = &serviceConnectionsResource{}
+	_ resource.ResourceWithImportState = &serviceConnectionsResource{}
+)
+
+func NewServiceConnectionsResource() resource.Resource {
+	return &serviceConnectionsResource{}
+}
+
+type serviceConnectionsResource struct {
+	client *sase.Client
+}
+
+type serviceConnectionsRsModel struct {
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
+
+	// Input.
+	Folder types.String `tfsdk:"folder"`
+
+	// Request body input.
+	// Ref: #/components/schemas/service-connections
+	BackupSC             types.String                             `tfsdk:"backup_s_c"`
+	BgpPeer              *serviceConnectionsRsModelBgpPeerObject  `tfsdk:"bgp_peer"`
+	ObjectId             types.String                             `tfsdk:"object_id"`
+	IpsecTunnel          types.String                             `tfsdk:"ipsec_tunnel"`
+	Name                 types.String                             `tfsdk:"name"`
+	NatPool              types.String                             `tfsdk:"nat_pool"`
+	NoExportCommunity    types.String                             `tfsdk:"no_export_community"`
+	OnboardingType       types.String                             `tfsdk:"onboarding_type"`
+	Protocol             *serviceConnectionsRsModelProtocolObject `tfsdk:"protocol"`
+	Qos                  *serviceConnectionsRsModelQosObject      `tfsdk:"qos"`
+	Region               types.String                             `tfsdk:"region"`
+	SecondaryIpsecTunnel types.String                             `tfsdk:"secondary_ipsec_tunnel"`
+	SourceNat            types.Bool                               `tfsdk:"source_nat"`
+	Subnets              []types.String                           `tfsdk:"subnets"`
+}
+
+type serviceConnectionsRsModelBgpPeerObject struct {
+	LocalIpAddress   types.String `tfsdk:"local_ip_address"`
+	LocalIpv6Address types.String `tfsdk:"local_ipv6_address"`
+	PeerIpAddress    types.String `tfsdk:"peer_ip_address"`
+	PeerIpv6Address  types.String `tfsdk:"peer_ipv6_address"`
+	SameAsPrimary    types.Bool   `tfsdk:"same_as_primary"`
+	Secret           types.String `tfsdk:"secret"`
+}
+
+type serviceConnectionsRsModelProtocolObject struct {
+	Bgp *serviceConnectionsRsModelBgpObject `tfsdk:"bgp"`
+}
+
+type serviceConnectionsRsModelBgpObject struct {
+	DoNotExportRoutes         types.Bool   `tfsdk:"do_not_export_routes"`
+	Enable                    types.Bool   `tfsdk:"enable"`
+	FastFailover              types.Bool   `tfsdk:"fast_failover"`
+	LocalIpAddress            types.String `tfsdk:"local_ip_address"`
+	OriginateDefaultRoute     types.Bool   `tfsdk:"originate_default_route"`
+	PeerAs                    types.String `tfsdk:"peer_as"`
+	PeerIpAddress             types.String `tfsdk:"peer_ip_address"`
+	Secret                    types.String `tfsdk:"secret"`
+	SummarizeMobileUserRoutes types.Bool   `tfsdk:"summarize_mobile_user_routes"`
+}
+
+type serviceConnectionsRsModelQosObject struct {
+	Enable     types.Bool   `tfsdk:"enable"`
+	QosProfile types.String `tfsdk:"qos_profile"`
+}
+
+// Metadata returns the data source type name.
+func (r *serviceConnectionsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_connections"
+}
+
+// Schema defines the schema for this listing data source.
+func (r *serviceConnectionsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rsschema.Schema{
+		Description: "Retrieves config for a specific item.",
+
+		Attributes: map[string]rsschema.Attribute{
+			"id": rsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Input.
+			"folder": rsschema.StringAttribute{
+				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"backup_s_c": rsschema.StringAttribute{
+				Description:         "The `backup_s_c` parameter.",
+				MarkdownDescription: "The `backup_s_c` parameter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					DefaultString(""),
+				},
+			},
+			"bgp_peer": rsschema.SingleNestedAttribute{
+				Description:         "The `bgp_peer` parameter.",
+				MarkdownDescription: "The `bgp_peer` parameter.",
+				Optional:            true,
+				Attributes: map[string]rsschema.Attribute{
+					"local_ip_address": rsschema.StringAttribute{
+						Description:         "The `local_ip_address` parameter. Value must be an IPv4 or IPv6 address.",
+						MarkdownDescription: "The `local_ip_address` parameter. Value must be an IPv4 or IPv6 address.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							DefaultString(""),
+						},
+						Validators: []validator.String{
+							IsIpAddress(),
+						},
+					},
+					"local_ipv6_address": rsschema.StringAttribute{
+						Description:         "The `local_ipv6_address` parameter. Value must be an IPv6 address.",
+						MarkdownDescription: "The `local_ipv6_address` parameter. Value must be an IPv6 address.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							DefaultString(""),
+						},
+						Validators: []validator.String{
+							IsIpv6Address(),
+						},
+					},
+					"peer_ip_address": rsschema.StringAttribute{
+						Description:         "The `peer_ip_address` parameter. Value must be an IPv4 or IPv6 address.",
+						MarkdownDescription: "The `peer_ip_address` parameter. Value must be an IPv4 or IPv6 address.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							DefaultString(""),
+						},
+						Validators: []validator.String{
+							IsIpAddress(),
+						},
+					},
+					"peer_ipv6_address": rsschema.StringAttribute{
+						Description:         "The `peer_ipv6_address` parameter. Value must be an IPv6 address.",
+						MarkdownDescription: "The `peer_ipv6_address` parameter. Value must be an IPv6 address.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							DefaultString(""),
+						},
+						Validators: []validator.String{
+							IsIpv6Address(),
+						},
+					},
+					"same_as_primary": rsschema.BoolAttribute{
+						Description:         "The `same_as_primary` parameter.",
+						MarkdownDescription: "The `same_as_primary` parameter.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							DefaultBool(false),
+						},
+					},
+					"secret": rsschema.StringAttribute{
+						Description:         "The `secret` parameter.",
+						MarkdownDescription: "The `secret` parameter.",
+						Optional:            true,
+						Computed:            true,
+						Sensitive:           true,
+						PlanModifiers: []planmodifier.String{
+							DefaultString(""),
+						},
+					},
+				},
+			},
+			"object_id": rsschema.StringAttribute{
+				Description:         "The `object_id` parameter.",
+				MarkdownDescription: "The `object_id` parameter.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ipsec_tunnel": rsschema.StringAttribute{
+				Description:         "The `ipsec_tunnel` parameter.",
+				MarkdownDescription: "The `ipsec_tunnel` parameter.",
+				Required:            true,
+			},
+			"name": rsschema.StringAttribute{
+				Description:         "The `name` parameter. String length must be at most 63.",
+				MarkdownDescription: "The `name` parameter. String length must be at most 63.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(63),
+				},
+			},
+			"nat_pool": rsschema.StringAttribute{
+				Description:         "The `nat_pool` parameter.",
+				MarkdownDescription: "The `nat_pool` parameter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					DefaultString(""),
+				},
+			},
+			"no_export_community": rsschema.StringAttribute{
+				Description:         "The `no_export_community` parameter. Value must be one of: `\"Disabled\"`, `\"Enabled-In\"`, `\"Enabled-Out\"`, `\"Enabled-Both\"`.",
+				MarkdownDescription: "The `no_export_community` parameter. Value must be one of: `\"Disabled\"`, `\"Enabled-In\"`, `\"Enabled-Out\"`, `\"Enabled-Both\"`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					DefaultString(""),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("Disabled", "Enabled-In", "Enabled-Out", "Enabled-Both"),
+				},
+			},
+			"onboarding_type": rsschema.StringAttribute{
+				Description:         "The `onboarding_type` parameter. Default: `\"classic\"`. Value must be one of: `\"classic\"`.",
+				MarkdownDescription: "The `onboarding_type` parameter. Default: `\"classic\"`. Value must be one of: `\"classic\"`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					DefaultString("classic"),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("classic"),
+				},
+			},
+			"protocol": rsschema.SingleNestedAttribute{
+				Description:         "The `protocol` parameter.",
+				MarkdownDescription: "The `protocol` parameter.",
+				Optional:            true,
+				Attributes: map[string]rsschema.Attribute{
+					"bgp": rsschema.SingleNestedAttribute{
+						Description:         "The `bgp` parameter.",
+						MarkdownDescription: "The `bgp` parameter.",
+						Optional:            true,
+						Attributes: map[string]rsschema.Attribute{
+							"do_not_export_routes": rsschema.BoolAttribute{
+								Description:         "The `do_not_export_routes` parameter.",
+								MarkdownDescription: "The `do_not_export_routes` parameter.",
+								Optional:            true,
+								Computed:            true,
+								PlanModifiers: []planmodifier.Bool{
+									DefaultBool(false),
+								},
+							},
+							"enable": rsschema.BoolAttribute{
+								Description:         "The `enable` parameter.",
+								MarkdownDescription: "The `enable` parameter.",
+								Optional:            true,
+								Computed:            true,
+								PlanModifiers: []planmodifier.Bool{
+									DefaultBool(false),
+								},
+							},
+							"fast_failover": rsschema.BoolAttribute{
+								Description:         "The `fast_failover` parameter.",
+								MarkdownDescription: "The `fast_failover` parameter.",
+								Optional:            true,
+								Computed:            true,
+								PlanModifiers: []planmodifier.Bool{
+									DefaultBool(false),
+								},
+							},
+							"local_ip_address": rsschema.StringAttribute{
+								Description:         "The `local_ip_address` parameter. Value must be an IPv4 or IPv6 address.",
+								MarkdownDescription: "The `local_ip_address` parameter. Value must be an IPv4 or IPv6 address.",
+								Optional:            true,
+								Computed:            true,
+								PlanModifiers: []planmodifier.String{
+									DefaultString(""),
+								},
+								Validators: []validator.String{
+									IsIpAddress(),
+								},
+							},
+							"originate_default_route": rsschema.BoolAttribute{
+								Description:         "The `originate_default_route` parameter.",
+								MarkdownDescription: "The `originate_default_route` parameter.",
+								Optional:            true,
+								Computed:            true,
+								PlanModifiers: []planmodifier.Bool{
+									DefaultBool(false),
+								},
+							},
+							"peer_as": rsschema.StringAttribute{
+								Description:         "The `peer_as` parameter. Value must be a 2-byte or 4-byte AS number, in plain or dotted notation.",
+								MarkdownDescription: "The `peer_as` parameter. Value must be a 2-byte or 4-byte AS number, in plain or dotted notation.",
+								Optional:            true,
+								Computed:            true,
+								PlanModifiers: []planmodifier.String{
+									DefaultString(""),
+								},
+								Validators: []validator.String{
+									IsAsn(),
+								},
+							},
+							"peer_ip_address": rsschema.StringAttribute{
+								Description:         "The `peer_ip_address` parameter. Value must be an IPv4 or IPv6 address.",
+								MarkdownDescription: "The `peer_ip_address` parameter. Value must be an IPv4 or IPv6 address.",
+								Optional:            true,
+								Computed:            true,
+								PlanModifiers: []planmodifier.String{
+									DefaultString(""),
+								},
+								Validators: []validator.String{
+									IsIpAddress(),
+								},
+							},
+							"secret": rsschema.StringAttribute{
+								Description:         "The `secret` parameter.",
+								MarkdownDescription: "The `secret` parameter.",
+								Optional:            true,
+								Computed:            true,
+								Sensitive:           true,
+								PlanModifiers: []planmodifier.String{
+									DefaultString(""),
+								},
+							},
+							"summarize_mobile_user_routes": rsschema.BoolAttribute{
+								Description:         "The `summarize_mobile_user_routes` parameter.",
+								MarkdownDescription: "The `summarize_mobile_user_routes` parameter.",
+								Optional:            true,
+								Computed:            true,
+								PlanModifiers: []planmodifier.Bool{
+									DefaultBool(false),
+								},
+							},
+						},
+					},
+				},
+			},
+			"qos": rsschema.SingleNestedAttribute{
+				Description:         "The `qos` parameter.",
+				MarkdownDescription: "The `qos` parameter.",
+				Optional:            true,
+				Attributes: map[string]rsschema.Attribute{
+					"enable": rsschema.BoolAttribute{
+						Description:         "The `enable` parameter.",
+						MarkdownDescription: "The `enable` parameter.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							DefaultBool(false),
+						},
+					},
+					"qos_profile": rsschema.StringAttribute{
+						Description:         "The `qos_profile` parameter.",
+						MarkdownDescription: "The `qos_profile` parameter.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							DefaultString(""),
+						},
+					},
+				},
+			},
+			"region": rsschema.StringAttribute{
+				Description:         "The `region` parameter. String length must be at least 1.",
+				MarkdownDescription: "The `region` parameter. String length must be at least 1.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"secondary_ipsec_tunnel": rsschema.StringAttribute{
+				Description:         "The `secondary_ipsec_tunnel` parameter.",
+				MarkdownDescription: "The `secondary_ipsec_tunnel` parameter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					DefaultString(""),
+				},
+			},
+			"source_nat": rsschema.BoolAttribute{
+				Description:         "The `source_nat` parameter.",
+				MarkdownDescription: "The `source_nat` parameter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					DefaultBool(false),
+				},
+			},
+			"subnets": rsschema.ListAttribute{
+				Description:         "The `subnets` parameter. Each value must be an IPv4 or IPv6 prefix in CIDR notation.",
+				MarkdownDescription: "The `subnets` parameter. Each value must be an IPv4 or IPv6 prefix in CIDR notation.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(IsIpPrefix()),
+				},
+			},
+		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_service_connections"),
+		},
+	}
+}
+
+// Configure prepares the struct.
+func (r *serviceConnectionsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*sase.Client)
+}
+
+// Create resource
+func (r *serviceConnectionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state serviceConnectionsRsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_service_connections", "create", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource create", map[string]any{
+		"terraform_provider_function": "Create",
+		"resource_name":               "sase_service_connections",
+		"folder":                      state.Folder.ValueString(),
+	})
+
+	// Prepare to create the config.
+	svc := yaiLoaU.NewClient(r.client)
+	input := yaiLoaU.CreateInput{
+		Folder: state.Folder.ValueString(),
+	}
+	var var0 kRfBnQa.Config
+	var0.BackupSC = state.BackupSC.ValueString()
+	var var1 *kRfBnQa.BgpPeerObject
+	if state.BgpPeer != nil {
+		var1 = &kRfBnQa.BgpPeerObject{}
+		var1.LocalIpAddress = state.BgpPeer.LocalIpAddress.ValueString()
+		var1.LocalIpv6Address = state.BgpPeer.LocalIpv6Address.ValueString()
+		var1.PeerIpAddress = state.BgpPeer.PeerIpAddress.ValueString()
+		var1.PeerIpv6Address = state.BgpPeer.PeerIpv6Address.ValueString()
+		var1.SameAsPrimary = state.BgpPeer.SameAsPrimary.ValueBool()
+		var1.Secret = state.BgpPeer.Secret.ValueString()
+	}
+	var0.BgpPeer = var1
+	var0.IpsecTunnel = state.IpsecTunnel.ValueString()
+	var0.Name = state.Name.ValueString()
+	var0.NatPool = state.NatPool.ValueString()
+	var0.NoExportCommunity = state.NoExportCommunity.ValueString()
+	var0.OnboardingType = state.OnboardingType.ValueString()
+	var var2 *kRfBnQa.ProtocolObject
+	if state.Protocol != nil {
+		var2 = &kRfBnQa.ProtocolObject{}
+		var var3 *kRfBnQa.BgpObject
+		if state.Protocol.Bgp != nil {
+			var3 = &kRfBnQa.BgpObject{}
+			var3.DoNotExportRoutes = state.Protocol.Bgp.DoNotExportRoutes.ValueBool()
+			var3.Enable = state.Protocol.Bgp.Enable.ValueBool()
+			var3.FastFailover = state.Protocol.Bgp.FastFailover.ValueBool()
+			var3.LocalIpAddress = state.Protocol.Bgp.LocalIpAddress.ValueString()
+			var3.OriginateDefaultRoute = state.Protocol.Bgp.OriginateDefaultRoute.ValueBool()
+			var3.PeerAs = state.Protocol.Bgp.PeerAs.ValueString()
+			var3.PeerIpAddress = state.Protocol.Bgp.PeerIpAddress.ValueString()
+			var3.Secret = state.Protocol.Bgp.Secret.ValueString()
+			var3.SummarizeMobileUserRoutes = state.Protocol.Bgp.SummarizeMobileUserRoutes.ValueBool()
+		}
+		var2.Bgp = var3
+	}
+	var0.Protocol = var2
+	var var4 *kRfBnQa.QosObject
+	if state.Qos != nil {
+		var4 = &kRfBnQa.QosObject{}
+		var4.Enable = state.Qos.Enable.ValueBool()
+		var4.QosProfile = state.Qos.QosProfile.ValueString()
+	}
+	var0.Qos = var4
+	var0.Region = state.Region.ValueString()
+	var0.SecondaryIpsecTunnel = state.SecondaryIpsecTunnel.ValueString()
+	var0.SourceNat = state.SourceNat.ValueBool()
+	var0.Subnets = DecodeStringSlice(state.Subnets)
+	input.Config = var0
+
+	// Perform the operation.
+	ans, err := svc.Create(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(input.Folder)
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(ans.ObjectId)
+	state.Id = types.StringValue(idBuilder.String())
+	var var5 *serviceConnectionsRsModelBgpPeerObject
+	if ans.BgpPeer != nil {
+		var5 = &serviceConnectionsRsModelBgpPeerObject{}
+		var5.LocalIpAddress = types.StringValue(ans.BgpPeer.LocalIpAddress)
+		var5.LocalIpv6Address = types.StringValue(ans.BgpPeer.LocalIpv6Address)
+		var5.PeerIpAddress = types.StringValue(ans.BgpPeer.PeerIpAddress)
+		var5.PeerIpv6Address = types.StringValue(ans.BgpPeer.PeerIpv6Address)
+		var5.SameAsPrimary = types.BoolValue(ans.BgpPeer.SameAsPrimary)
+		var5.Secret = types.StringValue(ans.BgpPeer.Secret)
+	}
+	var var6 *serviceConnectionsRsModelProtocolObject
+	if ans.Protocol != nil {
+		var6 = &serviceConnectionsRsModelProtocolObject{}
+		var var7 *serviceConnectionsRsModelBgpObject
+		if ans.Protocol.Bgp != nil {
+			var7 = &serviceConnectionsRsModelBgpObject{}
+			var7.DoNotExportRoutes = types.BoolValue(ans.Protocol.Bgp.DoNotExportRoutes)
+			var7.Enable = types.BoolValue(ans.Protocol.Bgp.Enable)
+			var7.FastFailover = types.BoolValue(ans.Protocol.Bgp.FastFailover)
+			var7.LocalIpAddress = types.StringValue(ans.Protocol.Bgp.LocalIpAddress)
+			var7.OriginateDefaultRoute = types.BoolValue(ans.Protocol.Bgp.OriginateDefaultRoute)
+			var7.PeerAs = types.StringValue(ans.Protocol.Bgp.PeerAs)
+			var7.PeerIpAddress = types.StringValue(ans.Protocol.Bgp.PeerIpAddress)
+			var7.Secret = types.StringValue(ans.Protocol.Bgp.Secret)
+			var7.SummarizeMobileUserRoutes = types.BoolValue(ans.Protocol.Bgp.SummarizeMobileUserRoutes)
+		}
+		var6.Bgp = var7
+	}
+	var var8 *serviceConnectionsRsModelQosObject
+	if ans.Qos != nil {
+		var8 = &serviceConnectionsRsModelQosObject{}
+		var8.Enable = types.BoolValue(ans.Qos.Enable)
+		var8.QosProfile = types.StringValue(ans.Qos.QosProfile)
+	}
+	state.BackupSC = types.StringValue(ans.BackupSC)
+	state.BgpPeer = var5
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.IpsecTunnel = types.StringValue(ans.IpsecTunnel)
+	state.Name = types.StringValue(ans.Name)
+	state.NatPool = types.StringValue(ans.NatPool)
+	state.NoExportCommunity = types.StringValue(ans.NoExportCommunity)
+	state.OnboardingType = types.StringValue(ans.OnboardingType)
+	state.Protocol = var6
+	state.Qos = var8
+	state.Region = types.StringValue(ans.Region)
+	state.SecondaryIpsecTunnel = types.StringValue(ans.SecondaryIpsecTunnel)
+	state.SourceNat = types.BoolValue(ans.SourceNat)
+	state.Subnets = EncodeStringSlice(ans.Subnets)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read resource.
+func (r *serviceConnectionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var idType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &idType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := idType.ValueString()
+	tokens := strings.Split(id, IdSeparator)
+	if len(tokens) != 2 {
+		resp.Diagnostics.AddError("Error in resource ID format", "Expected 2 tokens")
+		return
+	}
+
+	var state serviceConnectionsRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_service_connections", "read", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource read", map[string]any{
+		"terraform_provider_function": "Read",
+		"resource_name":               "sase_service_connections",
+		"locMap":                      map[string]int{"Folder": 0, "ObjectId": 1},
+		"tokens":                      tokens,
+	})
+
+	// Prepare to read the config.
+	svc := yaiLoaU.NewClient(r.client)
+	input := yaiLoaU.ReadInput{
+		ObjectId: tokens[1],
+		Folder:   tokens[0],
+	}
+
+	// Perform the operation.
+	ans, err := svc.Read(ctx, input)
+	if err != nil {
+		if IsObjectNotFound(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
+		}
+		return
+	}
+
+	// Store the answer to state.
+	state.Folder = types.StringValue(tokens[0])
+	state.Id = idType
+	var var0 *serviceConnectionsRsModelBgpPeerObject
+	if ans.BgpPeer != nil {
+		var0 = &serviceConnectionsRsModelBgpPeerObject{}
+		var0.LocalIpAddress = types.StringValue(ans.BgpPeer.LocalIpAddress)
+		var0.LocalIpv6Address = types.StringValue(ans.BgpPeer.LocalIpv6Address)
+		var0.PeerIpAddress = types.StringValue(ans.BgpPeer.PeerIpAddress)
+		var0.PeerIpv6Address = types.StringValue(ans.BgpPeer.PeerIpv6Address)
+		var0.SameAsPrimary = types.BoolValue(ans.BgpPeer.SameAsPrimary)
+		var0.Secret = types.StringValue(ans.BgpPeer.Secret)
+	}
+	var var1 *serviceConnectionsRsModelProtocolObject
+	if ans.Protocol != nil {
+		var1 = &serviceConnectionsRsModelProtocolObject{}
+		var var2 *serviceConnectionsRsModelBgpObject
+		if ans.Protocol.Bgp != nil {
+			var2 = &serviceConnectionsRsModelBgpObject{}
+			var2.DoNotExportRoutes = types.BoolValue(ans.Protocol.Bgp.DoNotExportRoutes)
+			var2.Enable = types.BoolValue(ans.Protocol.Bgp.Enable)
+			var2.FastFailover = types.BoolValue(ans.Protocol.Bgp.FastFailover)
+			var2.LocalIpAddress = types.StringValue(ans.Protocol.Bgp.LocalIpAddress)
+			var2.OriginateDefaultRoute = types.BoolValue(ans.Protocol.Bgp.OriginateDefaultRoute)
+			var2.PeerAs = types.StringValue(ans.Protocol.Bgp.PeerAs)
+			var2.PeerIpAddress = types.StringValue(ans.Protocol.Bgp.PeerIpAddress)
+			var2.Secret = types.StringValue(ans.Protocol.Bgp.Secret)
+			var2.SummarizeMobileUserRoutes = types.BoolValue(ans.Protocol.Bgp.SummarizeMobileUserRoutes)
+		}
+		var1.Bgp = var2
+	}
+	var var3 *serviceConnectionsRsModelQosObject
+	if ans.Qos != nil {
+		var3 = &serviceConnectionsRsModelQosObject{}
+		var3.Enable = types.BoolValue(ans.Qos.Enable)
+		var3.QosProfile = types.StringValue(ans.Qos.QosProfile)
+	}
+	state.BackupSC = types.StringValue(ans.BackupSC)
+	state.BgpPeer = var0
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.IpsecTunnel = types.StringValue(ans.IpsecTunnel)
+	state.Name = types.StringValue(ans.Name)
+	state.NatPool = types.StringValue(ans.NatPool)
+	state.NoExportCommunity = types.StringValue(ans.NoExportCommunity)
+	state.OnboardingType = types.StringValue(ans.OnboardingType)
+	state.Protocol = var1
+	state.Qos = var3
+	state.Region = types.StringValue(ans.Region)
+	state.SecondaryIpsecTunnel = types.StringValue(ans.SecondaryIpsecTunnel)
+	state.SourceNat = types.BoolValue(ans.SourceNat)
+	state.Subnets = EncodeStringSlice(ans.Subnets)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update resource.
+func (r *serviceConnectionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state serviceConnectionsRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_service_connections", "update", plan.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource update", map[string]any{
+		"terraform_provider_function": "Update",
+		"resource_name":               "sase_service_connections",
+		"object_id":                   state.ObjectId.ValueString(),
+	})
+
+	// Prepare to create the config.
+	svc := yaiLoaU.NewClient(r.client)
+	input := yaiLoaU.UpdateInput{
+		ObjectId: state.ObjectId.ValueString(),
+	}
+	var var0 kRfBnQa.Config
+	var0.BackupSC = plan.BackupSC.ValueString()
+	var var1 *kRfBnQa.BgpPeerObject
+	if plan.BgpPeer != nil {
+		var1 = &kRfBnQa.BgpPeerObject{}
+		var1.LocalIpAddress = plan.BgpPeer.LocalIpAddress.ValueString()
+		var1.LocalIpv6Address = plan.BgpPeer.LocalIpv6Address.ValueString()
+		var1.PeerIpAddress = plan.BgpPeer.PeerIpAddress.ValueString()
+		var1.PeerIpv6Address = plan.BgpPeer.PeerIpv6Address.ValueString()
+		var1.SameAsPrimary = plan.BgpPeer.SameAsPrimary.ValueBool()
+		var1.Secret = plan.BgpPeer.Secret.ValueString()
+	}
+	var0.BgpPeer = var1
+	var0.IpsecTunnel = plan.IpsecTunnel.ValueString()
+	var0.Name = plan.Name.ValueString()
+	var0.NatPool = plan.NatPool.ValueString()
+	var0.NoExportCommunity = plan.NoExportCommunity.ValueString()
+	var0.OnboardingType = plan.OnboardingType.ValueString()
+	var var2 *kRfBnQa.ProtocolObject
+	if plan.Protocol != nil {
+		var2 = &kRfBnQa.ProtocolObject{}
+		var var3 *kRfBnQa.BgpObject
+		if plan.Protocol.Bgp != nil {
+			var3 = &kRfBnQa.BgpObject{}
+			var3.DoNotExportRoutes = plan.Protocol.Bgp.DoNotExportRoutes.ValueBool()
+			var3.Enable = plan.Protocol.Bgp.Enable.ValueBool()
+			var3.FastFailover = plan.Protocol.Bgp.FastFailover.ValueBool()
+			var3.LocalIpAddress = plan.Protocol.Bgp.LocalIpAddress.ValueString()
+			var3.OriginateDefaultRoute = plan.Protocol.Bgp.OriginateDefaultRoute.ValueBool()
+			var3.PeerAs = plan.Protocol.Bgp.PeerAs.ValueString()
+			var3.PeerIpAddress = plan.Protocol.Bgp.PeerIpAddress.ValueString()
+			var3.Secret = plan.Protocol.Bgp.Secret.ValueString()
+			var3.SummarizeMobileUserRoutes = plan.Protocol.Bgp.SummarizeMobileUserRoutes.ValueBool()
+		}
+		var2.Bgp = var3
+	}
+	var0.Protocol = var2
+	var var4 *kRfBnQa.QosObject
+	if plan.Qos != nil {
+		var4 = &kRfBnQa.QosObject{}
+		var4.Enable = plan.Qos.Enable.ValueBool()
+		var4.QosProfile = plan.Qos.QosProfile.ValueString()
+	}
+	var0.Qos = var4
+	var0.Region = plan.Region.ValueString()
+	var0.SecondaryIpsecTunnel = plan.SecondaryIpsecTunnel.ValueString()
+	var0.SourceNat = plan.SourceNat.ValueBool()
+	var0.Subnets = DecodeStringSlice(plan.Subnets)
+	input.Config = var0
+
+	// Perform the operation.
+	ans, err := svc.Update(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
+	var var5 *serviceConnectionsRsModelBgpPeerObject
+	if ans.BgpPeer != nil {
+		var5 = &serviceConnectionsRsModelBgpPeerObject{}
+		var5.LocalIpAddress = types.StringValue(ans.BgpPeer.LocalIpAddress)
+		var5.LocalIpv6Address = types.StringValue(ans.BgpPeer.LocalIpv6Address)
+		var5.PeerIpAddress = types.StringValue(ans.BgpPeer.PeerIpAddress)
+		var5.PeerIpv6Address = types.StringValue(ans.BgpPeer.PeerIpv6Address)
+		var5.SameAsPrimary = types.BoolValue(ans.BgpPeer.SameAsPrimary)
+		var5.Secret = types.StringValue(ans.BgpPeer.Secret)
+	}
+	var var6 *serviceConnectionsRsModelProtocolObject
+	if ans.Protocol != nil {
+		var6 = &serviceConnectionsRsModelProtocolObject{}
+		var var7 *serviceConnectionsRsModelBgpObject
+		if ans.Protocol.Bgp != nil {
+			var7 = &serviceConnectionsRsModelBgpObject{}
+			var7.DoNotExportRoutes = types.BoolValue(ans.Protocol.Bgp.DoNotExportRoutes)
+			var7.Enable = types.BoolValue(ans.Protocol.Bgp.Enable)
+			var7.FastFailover = types.BoolValue(ans.Protocol.Bgp.FastFailover)
+			var7.LocalIpAddress = types.StringValue(ans.Protocol.Bgp.LocalIpAddress)
+			var7.OriginateDefaultRoute = types.BoolValue(ans.Protocol.Bgp.OriginateDefaultRoute)
+			var7.PeerAs = types.StringValue(ans.Protocol.Bgp.PeerAs)
+			var7.PeerIpAddress = types.StringValue(ans.Protocol.Bgp.PeerIpAddress)
+			var7.Secret = types.StringValue(ans.Protocol.Bgp.Secret)
+			var7.SummarizeMobileUserRoutes = types.BoolValue(ans.Protocol.Bgp.SummarizeMobileUserRoutes)
+		}
+		var6.Bgp = var7
+	}
+	var var8 *serviceConnectionsRsModelQosObject
+	if ans.Qos != nil {
+		var8 = &serviceConnectionsRsModelQosObject{}
+		var8.Enable = types.BoolValue(ans.Qos.Enable)
+		var8.QosProfile = types.StringValue(ans.Qos.QosProfile)
+	}
+	state.BackupSC = types.StringValue(ans.BackupSC)
+	state.BgpPeer = var5
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.IpsecTunnel = types.StringValue(ans.IpsecTunnel)
+	state.Name = types.StringValue(ans.Name)
+	state.NatPool = types.StringValue(ans.NatPool)
+	state.NoExportCommunity = types.StringValue(ans.NoExportCommunity)
+	state.OnboardingType = types.StringValue(ans.OnboardingType)
+	state.Protocol = var6
+	state.Qos = var8
+	state.Region = types.StringValue(ans.Region)
+	state.SecondaryIpsecTunnel = types.StringValue(ans.SecondaryIpsecTunnel)
+	state.SourceNat = types.BoolValue(ans.SourceNat)
+	state.Subnets = EncodeStringSlice(ans.Subnets)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete resource.
+func (r *serviceConnectionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var idType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &idType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_service_connections", "delete", timeouts)
+	defer cancel()
+
+	id := idType.ValueString()
+	tokens := strings.Split(id, IdSeparator)
+	if len(tokens) != 2 {
+		resp.Diagnostics.AddError("Error in resource ID format", "Expected 2 tokens")
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource delete", map[string]any{
+		"terraform_provider_function": "Delete",
+		"resource_name":               "sase_service_connections",
+		"locMap":                      map[string]int{"Folder": 0, "ObjectId": 1},
+		"tokens":                      tokens,
+	})
+
+	svc := yaiLoaU.NewClient(r.client)
+	input := yaiLoaU.DeleteInput{
+		ObjectId: tokens[1],
+	}
+
+	// Perform the operation.
+	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
+	}
+}
+
+// ImportState imports by either the resource ID or by location params and name,
+// such as `folder=...,name=...`.
+func (r *serviceConnectionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	params, ok, err := ParseImportId(req.ID, "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_service_connections",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := yaiLoaU.NewClient(r.client)
+	input := yaiLoaU.ListInput{
+		Folder: params["folder"],
+		Name:   api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
+}
@@ -2,15 +2,26 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"strings"
 
 	"github.com/paloaltonetworks/sase-go"
 	"github.com/paloaltonetworks/sase-go/api"
+	oRkTnWd "github.com/paloaltonetworks/sase-go/netsec/schema/shared/infrastructure/settings"
 	wHGMtfb "github.com/paloaltonetworks/sase-go/netsec/service/v1/sharedinfrastructuresettings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rsschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -33,8 +44,11 @@ type sharedInfrastructureSettingsListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64 `tfsdk:"limit"`
-	Offset types.Int64 `tfsdk:"offset"`
+	Limit    types.Int64 `tfsdk:"limit"`
+	Offset   types.Int64 `tfsdk:"offset"`
+	FetchAll types.Bool  `tfsdk:"fetch_all"`
+	PageSize types.Int64 `tfsdk:"page_size"`
+	MaxPages types.Int64 `tfsdk:"max_pages"`
 
 	// Output.
 	Data []sharedInfrastructureSettingsListDsModelConfig `tfsdk:"data"`
@@ -85,6 +99,27 @@ func (d *sharedInfrastructureSettingsListDataSource) Schema(_ context.Context, _
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 
 			// Output.
 			"data": dsschema.ListNestedAttribute{
@@ -97,6 +132,7 @@ func (d *sharedInfrastructureSettingsListDataSource) Schema(_ context.Context, _
 							Description:         "The `api_key` parameter.",
 							MarkdownDescription: "The `api_key` parameter.",
 							Computed:            true,
+							Sensitive:           true,
 						},
 						"captive_portal_redirect_ip_address": dsschema.StringAttribute{
 							Description:         "The `captive_portal_redirect_ip_address` parameter.",
@@ -187,12 +223,32 @@ func (d *sharedInfrastructureSettingsListDataSource) Read(ctx context.Context, r
 		input.Offset = api.Int(state.Offset.ValueInt64())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -238,3 +294,415 @@ func (d *sharedInfrastructureSettingsListDataSource) Read(ctx context.Context, r
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// Resource.
+var (
+	_ resource.Resource                = &sharedInfrastructureSettingsResource{}
+	_ resource.ResourceWithConfigure   = &sharedInfrastructureSettingsResource{}
+	_ resource.ResourceWithImportState = &sharedInfrastructureSettingsResource{}
+	_ resource.ResourceWithModifyPlan  = &sharedInfrastructureSettingsResource{}
+)
+
+func NewSharedInfrastructureSettingsResource() resource.Resource {
+	return &sharedInfrastructureSettingsResource{}
+}
+
+type sharedInfrastructureSettingsResource struct {
+	client *sase.Client
+}
+
+type sharedInfrastructureSettingsRsModel struct {
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
+
+	// Request body input.
+	// Ref: #/components/schemas/shared-infrastructure-settings
+	AllowInfrastructureSubnetChange types.Bool     `tfsdk:"allow_infrastructure_subnet_change"`
+	ApiKey                          types.String   `tfsdk:"api_key"`
+	CaptivePortalRedirectIpAddress  types.String   `tfsdk:"captive_portal_redirect_ip_address"`
+	EgressIpNotificationUrl         types.String   `tfsdk:"egress_ip_notification_url"`
+	InfraBgpAs                      types.String   `tfsdk:"infra_bgp_as"`
+	InfrastructureSubnet            types.String   `tfsdk:"infrastructure_subnet"`
+	InfrastructureSubnetIpv6        types.String   `tfsdk:"infrastructure_subnet_ipv6"`
+	Ipv6                            types.Bool     `tfsdk:"ipv6"`
+	LoopbackIps                     []types.String `tfsdk:"loopback_ips"`
+	TunnelMonitorIpAddress          types.String   `tfsdk:"tunnel_monitor_ip_address"`
+}
+
+// Metadata returns the data source type name.
+func (r *sharedInfrastructureSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_shared_infrastructure_settings"
+}
+
+// Schema defines the schema for this listing data source.
+func (r *sharedInfrastructureSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rsschema.Schema{
+		Description: "Manages the shared infrastructure settings. There is only one instance of these settings and they can't be reset, so deleting this resource only removes it from the state.",
+
+		Attributes: map[string]rsschema.Attribute{
+			"id": rsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"allow_infrastructure_subnet_change": rsschema.BoolAttribute{
+				Description:         "Allow changing `infrastructure_subnet` or `infrastructure_subnet_ipv6` after the tenant has been onboarded. Changing the infrastructure subnet redeploys the Prisma Access infrastructure. Default: `false`.",
+				MarkdownDescription: "Allow changing `infrastructure_subnet` or `infrastructure_subnet_ipv6` after the tenant has been onboarded. Changing the infrastructure subnet redeploys the Prisma Access infrastructure. Default: `false`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					DefaultBool(false),
+				},
+			},
+			"api_key": rsschema.StringAttribute{
+				Description:         "The `api_key` parameter.",
+				MarkdownDescription: "The `api_key` parameter.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"captive_portal_redirect_ip_address": rsschema.StringAttribute{
+				Description:         "The `captive_portal_redirect_ip_address` parameter. Value must be an IPv4 or IPv6 address.",
+				MarkdownDescription: "The `captive_portal_redirect_ip_address` parameter. Value must be an IPv4 or IPv6 address.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					DefaultString(""),
+				},
+				Validators: []validator.String{
+					IsIpAddress(),
+				},
+			},
+			"egress_ip_notification_url": rsschema.StringAttribute{
+				Description:         "The `egress_ip_notification_url` parameter.",
+				MarkdownDescription: "The `egress_ip_notification_url` parameter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					DefaultString(""),
+				},
+			},
+			"infra_bgp_as": rsschema.StringAttribute{
+				Description:         "The `infra_bgp_as` parameter. Value must be a 2-byte or 4-byte AS number.",
+				MarkdownDescription: "The `infra_bgp_as` parameter. Value must be a 2-byte or 4-byte AS number.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					DefaultString(""),
+				},
+				Validators: []validator.String{
+					IsAsn(),
+				},
+			},
+			"infrastructure_subnet": rsschema.StringAttribute{
+				Description:         "The `infrastructure_subnet` parameter. Value must be an IPv4 prefix in CIDR notation. The prefix length must be /24 or shorter.",
+				MarkdownDescription: "The `infrastructure_subnet` parameter. Value must be an IPv4 prefix in CIDR notation. The prefix length must be /24 or shorter.",
+				Required:            true,
+				Validators: []validator.String{
+					IsIpv4Prefix(),
+					PrefixLengthAtMost(24),
+				},
+			},
+			"infrastructure_subnet_ipv6": rsschema.StringAttribute{
+				Description:         "The `infrastructure_subnet_ipv6` parameter. Value must be an IPv6 prefix in CIDR notation. The prefix length must be /64 or shorter.",
+				MarkdownDescription: "The `infrastructure_subnet_ipv6` parameter. Value must be an IPv6 prefix in CIDR notation. The prefix length must be /64 or shorter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					DefaultString(""),
+				},
+				Validators: []validator.String{
+					IsIpv6Prefix(),
+					PrefixLengthAtMost(64),
+				},
+			},
+			"ipv6": rsschema.BoolAttribute{
+				Description:         "The `ipv6` parameter.",
+				MarkdownDescription: "The `ipv6` parameter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					DefaultBool(false),
+				},
+			},
+			"loopback_ips": rsschema.ListAttribute{
+				Description:         "The `loopback_ips` parameter. Each value must be an IPv4 or IPv6 address.",
+				MarkdownDescription: "The `loopback_ips` parameter. Each value must be an IPv4 or IPv6 address.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(IsIpAddress()),
+				},
+			},
+			"tunnel_monitor_ip_address": rsschema.StringAttribute{
+				Description:         "The `tunnel_monitor_ip_address` parameter. Value must be an IPv4 address.",
+				MarkdownDescription: "The `tunnel_monitor_ip_address` parameter. Value must be an IPv4 address.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					DefaultString(""),
+				},
+				Validators: []validator.String{
+					IsIpv4Address(),
+				},
+			},
+		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_shared_infrastructure_settings"),
+		},
+	}
+}
+
+// ModifyPlan rejects changes to the infrastructure subnet of an onboarded
+// tenant unless allow_infrastructure_subnet_change is set.
+func (r *sharedInfrastructureSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state sharedInfrastructureSettingsRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.AllowInfrastructureSubnetChange.ValueBool() {
+		return
+	}
+
+	checkInfrastructureSubnetChange(&resp.Diagnostics, path.Root("infrastructure_subnet"), state.InfrastructureSubnet.ValueString(), plan.InfrastructureSubnet)
+	checkInfrastructureSubnetChange(&resp.Diagnostics, path.Root("infrastructure_subnet_ipv6"), state.InfrastructureSubnetIpv6.ValueString(), plan.InfrastructureSubnetIpv6)
+}
+
+// Configure prepares the struct.
+func (r *sharedInfrastructureSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*sase.Client)
+}
+
+// Create resource
+func (r *sharedInfrastructureSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state sharedInfrastructureSettingsRsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_shared_infrastructure_settings", "create", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource create", map[string]any{
+		"terraform_provider_function": "Create",
+		"resource_name":               "sase_shared_infrastructure_settings",
+	})
+
+	svc := wHGMtfb.NewClient(r.client)
+
+	// An onboarded tenant already has an infrastructure subnet, so guard it
+	// the same way as an update does.
+	if !state.AllowInfrastructureSubnetChange.ValueBool() {
+		list, err := svc.List(ctx, wHGMtfb.ListInput{})
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
+			return
+		}
+		if len(list.Data) != 0 {
+			checkInfrastructureSubnetChange(&resp.Diagnostics, path.Root("infrastructure_subnet"), list.Data[0].InfrastructureSubnet, state.InfrastructureSubnet)
+			checkInfrastructureSubnetChange(&resp.Diagnostics, path.Root("infrastructure_subnet_ipv6"), list.Data[0].InfrastructureSubnetIpv6, state.InfrastructureSubnetIpv6)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	// Prepare to create the config.
+	input := wHGMtfb.UpdateInput{}
+	var var0 oRkTnWd.Config
+	var0.CaptivePortalRedirectIpAddress = state.CaptivePortalRedirectIpAddress.ValueString()
+	var0.EgressIpNotificationUrl = state.EgressIpNotificationUrl.ValueString()
+	var0.InfraBgpAs = state.InfraBgpAs.ValueString()
+	var0.InfrastructureSubnet = state.InfrastructureSubnet.ValueString()
+	var0.InfrastructureSubnetIpv6 = state.InfrastructureSubnetIpv6.ValueString()
+	var0.Ipv6 = state.Ipv6.ValueBool()
+	var0.LoopbackIps = DecodeStringSlice(state.LoopbackIps)
+	var0.TunnelMonitorIpAddress = state.TunnelMonitorIpAddress.ValueString()
+	input.Config = var0
+
+	// Perform the operation.
+	ans, err := svc.Update(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	state.Id = types.StringValue("shared_infrastructure_settings")
+	state.ApiKey = types.StringValue(ans.ApiKey)
+	state.CaptivePortalRedirectIpAddress = types.StringValue(ans.CaptivePortalRedirectIpAddress)
+	state.EgressIpNotificationUrl = types.StringValue(ans.EgressIpNotificationUrl)
+	state.InfraBgpAs = types.StringValue(ans.InfraBgpAs)
+	state.InfrastructureSubnet = types.StringValue(ans.InfrastructureSubnet)
+	state.InfrastructureSubnetIpv6 = types.StringValue(ans.InfrastructureSubnetIpv6)
+	state.Ipv6 = types.BoolValue(ans.Ipv6)
+	state.LoopbackIps = EncodeStringSlice(ans.LoopbackIps)
+	state.TunnelMonitorIpAddress = types.StringValue(ans.TunnelMonitorIpAddress)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read resource.
+func (r *sharedInfrastructureSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state sharedInfrastructureSettingsRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("allow_infrastructure_subnet_change"), &state.AllowInfrastructureSubnetChange)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.AllowInfrastructureSubnetChange.IsNull() {
+		state.AllowInfrastructureSubnetChange = types.BoolValue(false)
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_shared_infrastructure_settings", "read", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource read", map[string]any{
+		"terraform_provider_function": "Read",
+		"resource_name":               "sase_shared_infrastructure_settings",
+	})
+
+	// Prepare to read the config.
+	svc := wHGMtfb.NewClient(r.client)
+	input := wHGMtfb.ListInput{}
+
+	// Perform the operation.
+	list, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
+		return
+	}
+	if len(list.Data) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	ans := list.Data[0]
+
+	// Store the answer to state.
+	state.Id = types.StringValue("shared_infrastructure_settings")
+	state.ApiKey = types.StringValue(ans.ApiKey)
+	state.CaptivePortalRedirectIpAddress = types.StringValue(ans.CaptivePortalRedirectIpAddress)
+	state.EgressIpNotificationUrl = types.StringValue(ans.EgressIpNotificationUrl)
+	state.InfraBgpAs = types.StringValue(ans.InfraBgpAs)
+	state.InfrastructureSubnet = types.StringValue(ans.InfrastructureSubnet)
+	state.InfrastructureSubnetIpv6 = types.StringValue(ans.InfrastructureSubnetIpv6)
+	state.Ipv6 = types.BoolValue(ans.Ipv6)
+	state.LoopbackIps = EncodeStringSlice(ans.LoopbackIps)
+	state.TunnelMonitorIpAddress = types.StringValue(ans.TunnelMonitorIpAddress)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update resource.
+func (r *sharedInfrastructureSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state sharedInfrastructureSettingsRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_shared_infrastructure_settings", "update", plan.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource update", map[string]any{
+		"terraform_provider_function": "Update",
+		"resource_name":               "sase_shared_infrastructure_settings",
+	})
+
+	// Prepare to create the config.
+	svc := wHGMtfb.NewClient(r.client)
+	input := wHGMtfb.UpdateInput{}
+	var var0 oRkTnWd.Config
+	var0.CaptivePortalRedirectIpAddress = plan.CaptivePortalRedirectIpAddress.ValueString()
+	var0.EgressIpNotificationUrl = plan.EgressIpNotificationUrl.ValueString()
+	var0.InfraBgpAs = plan.InfraBgpAs.ValueString()
+	var0.InfrastructureSubnet = plan.InfrastructureSubnet.ValueString()
+	var0.InfrastructureSubnetIpv6 = plan.InfrastructureSubnetIpv6.ValueString()
+	var0.Ipv6 = plan.Ipv6.ValueBool()
+	var0.LoopbackIps = DecodeStringSlice(plan.LoopbackIps)
+	var0.TunnelMonitorIpAddress = plan.TunnelMonitorIpAddress.ValueString()
+	input.Config = var0
+
+	// Perform the operation.
+	ans, err := svc.Update(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
+	state.AllowInfrastructureSubnetChange = plan.AllowInfrastructureSubnetChange
+	state.ApiKey = types.StringValue(ans.ApiKey)
+	state.CaptivePortalRedirectIpAddress = types.StringValue(ans.CaptivePortalRedirectIpAddress)
+	state.EgressIpNotificationUrl = types.StringValue(ans.EgressIpNotificationUrl)
+	state.InfraBgpAs = types.StringValue(ans.InfraBgpAs)
+	state.InfrastructureSubnet = types.StringValue(ans.InfrastructureSubnet)
+	state.InfrastructureSubnetIpv6 = types.StringValue(ans.InfrastructureSubnetIpv6)
+	state.Ipv6 = types.BoolValue(ans.Ipv6)
+	state.LoopbackIps = EncodeStringSlice(ans.LoopbackIps)
+	state.TunnelMonitorIpAddress = types.StringValue(ans.TunnelMonitorIpAddress)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete resource.
+func (r *sharedInfrastructureSettingsResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Basic logging.
+	tflog.Info(ctx, "performing resource delete", map[string]any{
+		"terraform_provider_function": "Delete",
+		"resource_name":               "sase_shared_infrastructure_settings",
+	})
+
+	// The settings can't be reset once the tenant is onboarded, so leave them
+	// as they are and only remove them from the state.
+	resp.Diagnostics.AddWarning(
+		"Settings Left In Place",
+		"The shared infrastructure settings can't be removed, so they were only removed from the Terraform state.",
+	)
+}
+
+// ImportState imports the settings.  As there is only one instance of them,
+// the import ID is ignored.
+func (r *sharedInfrastructureSettingsResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), "shared_infrastructure_settings")...)
+}
+
+// checkInfrastructureSubnetChange adds an error if the current infrastructure
+// subnet is set and the planned one differs from it.
+func checkInfrastructureSubnetChange(diags *diag.Diagnostics, p path.Path, current string, planned types.String) {
+	if current == "" || planned.IsUnknown() || planned.ValueString() == current {
+		return
+	}
+
+	diags.AddAttributeError(
+		p,
+		"Infrastructure Subnet Change",
+		fmt.Sprintf("The tenant is already onboarded with %s %q. Changing it to %q redeploys the Prisma Access infrastructure. Set allow_infrastructure_subnet_change to true to proceed.", p, current, planned.ValueString()),
+	)
+}
@@ -42,10 +42,13 @@ type tacacsServerProfilesListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Folder types.String `tfsdk:"folder"`
-	Name   types.String `tfsdk:"name"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Folder   types.String `tfsdk:"folder"`
+	Name     types.String `tfsdk:"name"`
 
 	// Output.
 	Data []tacacsServerProfilesListDsModelConfig `tfsdk:"data"`
@@ -99,6 +102,27 @@ func (d *tacacsServerProfilesListDataSource) Schema(_ context.Context, _ datasou
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"folder": dsschema.StringAttribute{
 				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
 				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
@@ -155,6 +179,7 @@ func (d *tacacsServerProfilesListDataSource) Schema(_ context.Context, _ datasou
 										Description:         "The `secret` parameter.",
 										MarkdownDescription: "The `secret` parameter.",
 										Computed:            true,
+										Sensitive:           true,
 									},
 								},
 							},
@@ -225,12 +250,32 @@ func (d *tacacsServerProfilesListDataSource) Read(ctx context.Context, req datas
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -392,6 +437,7 @@ func (d *tacacsServerProfilesDataSource) Schema(_ context.Context, _ datasource.
 							Description:         "The `secret` parameter.",
 							MarkdownDescription: "The `secret` parameter.",
 							Computed:            true,
+							Sensitive:           true,
 						},
 					},
 				},
@@ -493,11 +539,16 @@ type tacacsServerProfilesResource struct {
 }
 
 type tacacsServerProfilesRsModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
 
 	// Input.
 	Folder types.String `tfsdk:"folder"`
 
+	// Secrets.
+	SecretHashes  map[string]types.String `tfsdk:"secret_hashes"`
+	SecretVersion types.String            `tfsdk:"secret_version"`
+
 	// Request body input.
 	// Ref: #/components/schemas/tacacs-server-profiles
 	ObjectId            types.String                              `tfsdk:"object_id"`
@@ -514,6 +565,16 @@ type tacacsServerProfilesRsModelServerObject struct {
 	Secret  types.String `tfsdk:"secret"`
 }
 
+// secrets returns the write-only secrets in the model, keyed by location.
+func (o *tacacsServerProfilesRsModel) secrets() map[string]*types.String {
+	ans := make(map[string]*types.String, len(o.Server))
+	for i := range o.Server {
+		ans["server."+o.Server[i].Name.ValueString()+".secret"] = &o.Server[i].Secret
+	}
+
+	return ans
+}
+
 // Metadata returns the data source type name.
 func (r *tacacsServerProfilesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_tacacs_server_profiles"
@@ -542,6 +603,22 @@ func (r *tacacsServerProfilesResource) Schema(_ context.Context, _ resource.Sche
 				Validators: []validator.String{
 					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Secrets.
+			"secret_hashes": rsschema.MapAttribute{
+				Description:         "Hashes of the encrypted secrets returned by the server, used to detect secrets changed outside of Terraform.",
+				MarkdownDescription: "Hashes of the encrypted secrets returned by the server, used to detect secrets changed outside of Terraform.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"secret_version": rsschema.StringAttribute{
+				Description:         "An arbitrary value that, when changed, forces all secrets to be sent to the server again.",
+				MarkdownDescription: "An arbitrary value that, when changed, forces all secrets to be sent to the server again.",
+				Optional:            true,
 			},
 
 			"object_id": rsschema.StringAttribute{
@@ -567,13 +644,16 @@ func (r *tacacsServerProfilesResource) Schema(_ context.Context, _ resource.Sche
 				NestedObject: rsschema.NestedAttributeObject{
 					Attributes: map[string]rsschema.Attribute{
 						"address": rsschema.StringAttribute{
-							Description:         "The `address` parameter.",
-							MarkdownDescription: "The `address` parameter.",
+							Description:         "The `address` parameter. Value must be an IPv4 or IPv6 address or a fully qualified domain name.",
+							MarkdownDescription: "The `address` parameter. Value must be an IPv4 or IPv6 address or a fully qualified domain name.",
 							Optional:            true,
 							Computed:            true,
 							PlanModifiers: []planmodifier.String{
 								DefaultString(""),
 							},
+							Validators: []validator.String{
+								IsIpAddressOrFqdn(),
+							},
 						},
 						"name": rsschema.StringAttribute{
 							Description:         "The `name` parameter.",
@@ -601,6 +681,7 @@ func (r *tacacsServerProfilesResource) Schema(_ context.Context, _ resource.Sche
 							MarkdownDescription: "The `secret` parameter. String length must be at most 64.",
 							Optional:            true,
 							Computed:            true,
+							Sensitive:           true,
 							PlanModifiers: []planmodifier.String{
 								DefaultString(""),
 							},
@@ -633,6 +714,9 @@ func (r *tacacsServerProfilesResource) Schema(_ context.Context, _ resource.Sche
 				},
 			},
 		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_tacacs_server_profiles"),
+		},
 	}
 }
 
@@ -653,6 +737,9 @@ func (r *tacacsServerProfilesResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_tacacs_server_profiles", "create", state.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource create", map[string]any{
 		"terraform_provider_function": "Create",
@@ -688,10 +775,13 @@ func (r *tacacsServerProfilesResource) Create(ctx context.Context, req resource.
 	// Perform the operation.
 	ans, err := svc.Create(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in create", err.Error())
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
 		return
 	}
 
+	// Save the plaintext secrets before they are overwritten.
+	plan := state
+
 	// Store the answer to state.
 	var idBuilder strings.Builder
 	idBuilder.WriteString(input.Folder)
@@ -717,6 +807,9 @@ func (r *tacacsServerProfilesResource) Create(ctx context.Context, req resource.
 	state.Timeout = types.Int64Value(ans.Timeout)
 	state.UseSingleConnection = types.BoolValue(ans.UseSingleConnection)
 
+	// Keep the plaintext secrets instead of what the server returned.
+	state.SecretHashes = SaveSecrets(state.secrets(), plan.secrets())
+
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -736,6 +829,13 @@ func (r *tacacsServerProfilesResource) Read(ctx context.Context, req resource.Re
 	}
 
 	var state tacacsServerProfilesRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_tacacs_server_profiles", "read", state.Timeouts)
+	defer cancel()
 
 	// Basic logging.
 	tflog.Info(ctx, "performing resource read", map[string]any{
@@ -758,7 +858,7 @@ func (r *tacacsServerProfilesResource) Read(ctx context.Context, req resource.Re
 		if IsObjectNotFound(err) {
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error reading config", err.Error())
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
 		}
 		return
 	}
@@ -785,6 +885,15 @@ func (r *tacacsServerProfilesResource) Read(ctx context.Context, req resource.Re
 	state.Timeout = types.Int64Value(ans.Timeout)
 	state.UseSingleConnection = types.BoolValue(ans.UseSingleConnection)
 
+	// Keep the plaintext secrets if they are unchanged on the server.
+	var prior tacacsServerProfilesRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.SecretHashes = RefreshSecrets(state.secrets(), prior.secrets(), prior.SecretHashes)
+	state.SecretVersion = prior.SecretVersion
+
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -802,6 +911,9 @@ func (r *tacacsServerProfilesResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_tacacs_server_profiles", "update", plan.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource update", map[string]any{
 		"terraform_provider_function": "Update",
@@ -837,11 +949,12 @@ func (r *tacacsServerProfilesResource) Update(ctx context.Context, req resource.
 	// Perform the operation.
 	ans, err := svc.Update(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in update", err.Error())
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
 		return
 	}
 
 	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
 	var var4 []tacacsServerProfilesRsModelServerObject
 	if len(ans.Server) != 0 {
 		var4 = make([]tacacsServerProfilesRsModelServerObject, 0, len(ans.Server))
@@ -861,6 +974,10 @@ func (r *tacacsServerProfilesResource) Update(ctx context.Context, req resource.
 	state.Timeout = types.Int64Value(ans.Timeout)
 	state.UseSingleConnection = types.BoolValue(ans.UseSingleConnection)
 
+	// Keep the plaintext secrets instead of what the server returned.
+	state.SecretHashes = SaveSecrets(state.secrets(), plan.secrets())
+	state.SecretVersion = plan.SecretVersion
+
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -872,6 +989,16 @@ func (r *tacacsServerProfilesResource) Delete(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_tacacs_server_profiles", "delete", timeouts)
+	defer cancel()
+
 	id := idType.ValueString()
 	tokens := strings.Split(id, IdSeparator)
 	if len(tokens) != 2 {
@@ -894,10 +1021,59 @@ func (r *tacacsServerProfilesResource) Delete(ctx context.Context, req resource.
 
 	// Perform the operation.
 	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
-		resp.Diagnostics.AddError("Error in delete", err.Error())
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
 	}
 }
 
+// ImportState imports by either the resource ID or by location params and name,
+// such as `folder=...,name=...`.
 func (r *tacacsServerProfilesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	params, ok, err := ParseImportId(req.ID, "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_tacacs_server_profiles",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := lUnrbOf.NewClient(r.client)
+	input := lUnrbOf.ListInput{
+		Folder: params["folder"],
+		Name:   api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
 }
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TimeoutDefaults are the timeouts of the resource operations when they are not
+// configured in the `timeouts` block.
+type TimeoutDefaults struct {
+	Create time.Duration
+	Read   time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+// DefaultTimeouts is used for resources without an entry in ResourceTimeouts.
+var DefaultTimeouts = TimeoutDefaults{
+	Create: 10 * time.Minute,
+	Read:   5 * time.Minute,
+	Update: 10 * time.Minute,
+	Delete: 10 * time.Minute,
+}
+
+// ResourceTimeouts are the default timeouts of resources that are slower than
+// most, keyed by resource type name.
+var ResourceTimeouts = map[string]TimeoutDefaults{
+	"sase_candidate_push": {
+		Create: 60 * time.Minute,
+		Read:   5 * time.Minute,
+		Update: 10 * time.Minute,
+		Delete: 10 * time.Minute,
+	},
+	"sase_ike_gateways": {
+		Create: 20 * time.Minute,
+		Read:   5 * time.Minute,
+		Update: 20 * time.Minute,
+		Delete: 20 * time.Minute,
+	},
+	"sase_ipsec_tunnels": {
+		Create: 30 * time.Minute,
+		Read:   5 * time.Minute,
+		Update: 30 * time.Minute,
+		Delete: 30 * time.Minute,
+	},
+	"sase_remote_networks": {
+		Create: 30 * time.Minute,
+		Read:   5 * time.Minute,
+		Update: 30 * time.Minute,
+		Delete: 30 * time.Minute,
+	},
+	"sase_service_connections": {
+		Create: 30 * time.Minute,
+		Read:   5 * time.Minute,
+		Update: 30 * time.Minute,
+		Delete: 30 * time.Minute,
+	},
+	"sase_shared_infrastructure_settings": {
+		Create: 30 * time.Minute,
+		Read:   5 * time.Minute,
+		Update: 30 * time.Minute,
+		Delete: 10 * time.Minute,
+	},
+}
+
+func resourceTimeouts(name string) TimeoutDefaults {
+	if x, ok := ResourceTimeouts[name]; ok {
+		return x
+	}
+
+	return DefaultTimeouts
+}
+
+// TimeoutsBlock returns the `timeouts` block for the given resource type.
+func TimeoutsBlock(name string) schema.Block {
+	defaults := resourceTimeouts(name)
+
+	attr := func(op string, d time.Duration) schema.StringAttribute {
+		desc := fmt.Sprintf("The timeout of the %s operation, such as `30s` or `2h45m`. Default: `%s`.", op, d)
+		return schema.StringAttribute{
+			Description:         desc,
+			MarkdownDescription: desc,
+			Optional:            true,
+			Validators: []validator.String{
+				IsDuration(),
+			},
+		}
+	}
+
+	return schema.SingleNestedBlock{
+		Description:         "Timeouts of the resource operations.",
+		MarkdownDescription: "Timeouts of the resource operations.",
+		Attributes: map[string]schema.Attribute{
+			"create": attr("create", defaults.Create),
+			"read":   attr("read", defaults.Read),
+			"update": attr("update", defaults.Update),
+			"delete": attr("delete", defaults.Delete),
+		},
+	}
+}
+
+type operationTimeoutKey struct{}
+
+type operationTimeout struct {
+	op string
+	d  time.Duration
+}
+
+// WithTimeout returns a context with the timeout of the given operation ("create",
+// "read", "update" or "delete"), taken from the `timeouts` block if configured
+// and from the resource type's defaults otherwise.
+func WithTimeout(ctx context.Context, name, op string, timeouts types.Object) (context.Context, context.CancelFunc) {
+	defaults := resourceTimeouts(name)
+	var d time.Duration
+	switch op {
+	case "create":
+		d = defaults.Create
+	case "read":
+		d = defaults.Read
+	case "update":
+		d = defaults.Update
+	case "delete":
+		d = defaults.Delete
+	}
+
+	// The value was checked by the validator, so it parses.
+	if !timeouts.IsNull() && !timeouts.IsUnknown() {
+		if v, ok := timeouts.Attributes()[op].(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+			if x, err := time.ParseDuration(v.ValueString()); err == nil {
+				d = x
+			}
+		}
+	}
+
+	ctx = context.WithValue(ctx, operationTimeoutKey{}, operationTimeout{op, d})
+	return context.WithTimeout(ctx, d)
+}
+
+// OperationError returns the error message for err, naming the operation that
+// timed out if the error is due to the context from WithTimeout.
+func OperationError(ctx context.Context, err error) string {
+	x, ok := ctx.Value(operationTimeoutKey{}).(operationTimeout)
+	if !ok || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return err.Error()
+	}
+
+	return fmt.Sprintf("The %s operation timed out after %s: %s", x.op, x.d, err)
+}
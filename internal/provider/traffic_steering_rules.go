@@ -7,11 +7,18 @@ import (
 
 	"github.com/paloaltonetworks/sase-go"
 	"github.com/paloaltonetworks/sase-go/api"
+	hVbNsPq "github.com/paloaltonetworks/sase-go/netsec/schema/traffic/steering/rules"
 	wWVKIJO "github.com/paloaltonetworks/sase-go/netsec/service/v1/trafficsteeringrules"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rsschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -35,10 +42,13 @@ type trafficSteeringRulesListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Name   types.String `tfsdk:"name"`
-	Folder types.String `tfsdk:"folder"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Name     types.String `tfsdk:"name"`
+	Folder   types.String `tfsdk:"folder"`
 
 	// Output.
 	Data []trafficSteeringRulesListDsModelConfig `tfsdk:"data"`
@@ -96,6 +106,27 @@ func (d *trafficSteeringRulesListDataSource) Schema(_ context.Context, _ datasou
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"name": dsschema.StringAttribute{
 				Description:         "The name of the entry.",
 				MarkdownDescription: "The name of the entry.",
@@ -232,12 +263,32 @@ func (d *trafficSteeringRulesListDataSource) Read(ctx context.Context, req datas
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -300,3 +351,779 @@ func (d *trafficSteeringRulesListDataSource) Read(ctx context.Context, req datas
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// Data source.
+var (
+	_ datasource.DataSource              = &trafficSteeringRulesDataSource{}
+	_ datasource.DataSourceWithConfigure = &trafficSteeringRulesDataSource{}
+)
+
+func NewTrafficSteeringRulesDataSource() datasource.DataSource {
+	return &trafficSteeringRulesDataSource{}
+}
+
+type trafficSteeringRulesDataSource struct {
+	client *sase.Client
+}
+
+type trafficSteeringRulesDsModel struct {
+	Id types.String `tfsdk:"id"`
+
+	// Input.
+	ObjectId types.String `tfsdk:"object_id"`
+	Folder   types.String `tfsdk:"folder"`
+
+	// Output.
+	// Ref: #/components/schemas/traffic-steering-rules
+	Action      *trafficSteeringRulesDsModelActionObject `tfsdk:"action"`
+	Category    []types.String                           `tfsdk:"category"`
+	Destination []types.String                           `tfsdk:"destination"`
+	// input omit: ObjectId
+	Name       types.String   `tfsdk:"name"`
+	Service    []types.String `tfsdk:"service"`
+	Source     []types.String `tfsdk:"source"`
+	SourceUser []types.String `tfsdk:"source_user"`
+}
+
+type trafficSteeringRulesDsModelActionObject struct {
+	Forward *trafficSteeringRulesDsModelForwardObject `tfsdk:"forward"`
+	NoPbf   types.Bool                                `tfsdk:"no_pbf"`
+}
+
+type trafficSteeringRulesDsModelForwardObject struct {
+	Target types.String `tfsdk:"target"`
+}
+
+// Metadata returns the data source type name.
+func (d *trafficSteeringRulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_traffic_steering_rules"
+}
+
+// Schema defines the schema for this listing data source.
+func (d *trafficSteeringRulesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dsschema.Schema{
+		Description: "Retrieves config for a specific item.",
+
+		Attributes: map[string]dsschema.Attribute{
+			"id": dsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+			},
+
+			// Input.
+			"object_id": dsschema.StringAttribute{
+				Description:         "The uuid of the resource.",
+				MarkdownDescription: "The uuid of the resource.",
+				Required:            true,
+			},
+			"folder": dsschema.StringAttribute{
+				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
+				},
+			},
+
+			// Output.
+			"action": dsschema.SingleNestedAttribute{
+				Description:         "The `action` parameter.",
+				MarkdownDescription: "The `action` parameter.",
+				Computed:            true,
+				Attributes: map[string]dsschema.Attribute{
+					"forward": dsschema.SingleNestedAttribute{
+						Description:         "The `forward` parameter.",
+						MarkdownDescription: "The `forward` parameter.",
+						Computed:            true,
+						Attributes: map[string]dsschema.Attribute{
+							"target": dsschema.StringAttribute{
+								Description:         "The `target` parameter.",
+								MarkdownDescription: "The `target` parameter.",
+								Computed:            true,
+							},
+						},
+					},
+					"no_pbf": dsschema.BoolAttribute{
+						Description:         "The `no_pbf` parameter.",
+						MarkdownDescription: "The `no_pbf` parameter.",
+						Computed:            true,
+					},
+				},
+			},
+			"category": dsschema.ListAttribute{
+				Description:         "The `category` parameter.",
+				MarkdownDescription: "The `category` parameter.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"destination": dsschema.ListAttribute{
+				Description:         "The `destination` parameter.",
+				MarkdownDescription: "The `destination` parameter.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"name": dsschema.StringAttribute{
+				Description:         "The `name` parameter.",
+				MarkdownDescription: "The `name` parameter.",
+				Computed:            true,
+			},
+			"service": dsschema.ListAttribute{
+				Description:         "The `service` parameter.",
+				MarkdownDescription: "The `service` parameter.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"source": dsschema.ListAttribute{
+				Description:         "The `source` parameter.",
+				MarkdownDescription: "The `source` parameter.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"source_user": dsschema.ListAttribute{
+				Description:         "The `source_user` parameter.",
+				MarkdownDescription: "The `source_user` parameter.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+// Configure prepares the struct.
+func (d *trafficSteeringRulesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*sase.Client)
+}
+
+func (d *trafficSteeringRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state trafficSteeringRulesDsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing data source singleton retrieval", map[string]any{
+		"terraform_provider_function": "Read",
+		"data_source_name":            "sase_traffic_steering_rules",
+		"object_id":                   state.ObjectId.ValueString(),
+		"folder":                      state.Folder.ValueString(),
+	})
+
+	// Prepare to run the command.
+	svc := wWVKIJO.NewClient(d.client)
+	input := wWVKIJO.ReadInput{
+		ObjectId: state.ObjectId.ValueString(),
+		Folder:   state.Folder.ValueString(),
+	}
+
+	// Perform the operation.
+	ans, err := svc.Read(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting singleton", err.Error())
+		return
+	}
+
+	// Store the answer to state.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(input.ObjectId)
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(input.Folder)
+	state.Id = types.StringValue(idBuilder.String())
+	var var0 *trafficSteeringRulesDsModelActionObject
+	if ans.Action != nil {
+		var0 = &trafficSteeringRulesDsModelActionObject{}
+		var var1 *trafficSteeringRulesDsModelForwardObject
+		if ans.Action.Forward != nil {
+			var1 = &trafficSteeringRulesDsModelForwardObject{}
+			var1.Target = types.StringValue(ans.Action.Forward.Target)
+		}
+		var0.Forward = var1
+		if ans.Action.NoPbf != nil {
+			var0.NoPbf = types.BoolValue(true)
+		}
+	}
+	state.Action = var0
+	state.Category = EncodeStringSlice(ans.Category)
+	state.Destination = EncodeStringSlice(ans.Destination)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.Name = types.StringValue(ans.Name)
+	state.Service = EncodeStringSlice(ans.Service)
+	state.Source = EncodeStringSlice(ans.Source)
+	state.SourceUser = EncodeStringSlice(ans.SourceUser)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Resource.
+var (
+	_ resource.Resource                     = &trafficSteeringRulesResource{}
+	_ resource.ResourceWithConfigure        = &trafficSteeringRulesResource{}
+	_ resource.ResourceWithImportState      = &trafficSteeringRulesResource{}
+	_ resource.ResourceWithConfigValidators = &trafficSteeringRulesResource{}
+)
+
+func NewTrafficSteeringRulesResource() resource.Resource {
+	return &trafficSteeringRulesResource{}
+}
+
+type trafficSteeringRulesResource struct {
+	client *sase.Client
+}
+
+type trafficSteeringRulesRsModel struct {
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
+
+	// Input.
+	Folder           types.String `tfsdk:"folder"`
+	RelativePosition types.String `tfsdk:"relative_position"`
+	TargetRule       types.String `tfsdk:"target_rule"`
+
+	// Request body input.
+	// Ref: #/components/schemas/traffic-steering-rules
+	Action      *trafficSteeringRulesRsModelActionObject `tfsdk:"action"`
+	Category    []types.String                           `tfsdk:"category"`
+	Destination []types.String                           `tfsdk:"destination"`
+	ObjectId    types.String                             `tfsdk:"object_id"`
+	Name        types.String                             `tfsdk:"name"`
+	Service     []types.String                           `tfsdk:"service"`
+	Source      []types.String                           `tfsdk:"source"`
+	SourceUser  []types.String                           `tfsdk:"source_user"`
+}
+
+type trafficSteeringRulesRsModelActionObject struct {
+	Forward *trafficSteeringRulesRsModelForwardObject `tfsdk:"forward"`
+	NoPbf   types.Bool                                `tfsdk:"no_pbf"`
+}
+
+type trafficSteeringRulesRsModelForwardObject struct {
+	Target types.String `tfsdk:"target"`
+}
+
+// Metadata returns the data source type name.
+func (r *trafficSteeringRulesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_traffic_steering_rules"
+}
+
+// Schema defines the schema for this listing data source.
+func (r *trafficSteeringRulesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rsschema.Schema{
+		Description: "Retrieves config for a specific item.",
+
+		Attributes: map[string]rsschema.Attribute{
+			"id": rsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Input.
+			"folder": rsschema.StringAttribute{
+				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"relative_position": rsschema.StringAttribute{
+				Description:         "Where to move the rule within the rulebase when it is created or updated. If unset, the rule is not moved. Value must be one of: `\"top\"`, `\"bottom\"`, `\"before\"`, `\"after\"`.",
+				MarkdownDescription: "Where to move the rule within the rulebase when it is created or updated. If unset, the rule is not moved. Value must be one of: `\"top\"`, `\"bottom\"`, `\"before\"`, `\"after\"`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(RuleDestinations...),
+				},
+			},
+			"target_rule": rsschema.StringAttribute{
+				Description:         "The object ID of the rule to move this rule before or after. Required if `relative_position` is `\"before\"` or `\"after\"`.",
+				MarkdownDescription: "The object ID of the rule to move this rule before or after. Required if `relative_position` is `\"before\"` or `\"after\"`.",
+				Optional:            true,
+			},
+
+			"action": rsschema.SingleNestedAttribute{
+				Description:         "The `action` parameter.",
+				MarkdownDescription: "The `action` parameter.",
+				Required:            true,
+				Attributes: map[string]rsschema.Attribute{
+					"forward": rsschema.SingleNestedAttribute{
+						Description:         "The `forward` parameter.",
+						MarkdownDescription: "The `forward` parameter.",
+						Optional:            true,
+						Attributes: map[string]rsschema.Attribute{
+							"target": rsschema.StringAttribute{
+								Description:         "The `target` parameter.",
+								MarkdownDescription: "The `target` parameter.",
+								Required:            true,
+							},
+						},
+					},
+					"no_pbf": rsschema.BoolAttribute{
+						Description:         "The `no_pbf` parameter.",
+						MarkdownDescription: "The `no_pbf` parameter.",
+						Optional:            true,
+					},
+				},
+			},
+			"category": rsschema.ListAttribute{
+				Description:         "The `category` parameter.",
+				MarkdownDescription: "The `category` parameter.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"destination": rsschema.ListAttribute{
+				Description:         "The `destination` parameter.",
+				MarkdownDescription: "The `destination` parameter.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"object_id": rsschema.StringAttribute{
+				Description:         "The `object_id` parameter.",
+				MarkdownDescription: "The `object_id` parameter.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": rsschema.StringAttribute{
+				Description:         "The `name` parameter. String length must be at most 63.",
+				MarkdownDescription: "The `name` parameter. String length must be at most 63.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(63),
+				},
+			},
+			"service": rsschema.ListAttribute{
+				Description:         "The `service` parameter.",
+				MarkdownDescription: "The `service` parameter.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"source": rsschema.ListAttribute{
+				Description:         "The `source` parameter.",
+				MarkdownDescription: "The `source` parameter.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"source_user": rsschema.ListAttribute{
+				Description:         "The `source_user` parameter.",
+				MarkdownDescription: "The `source_user` parameter.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_traffic_steering_rules"),
+		},
+	}
+}
+
+// ConfigValidators returns the checks for mutually exclusive attributes.
+func (r *trafficSteeringRulesResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		ExactlyOneOfNested(path.MatchRoot("action"), "forward", "no_pbf"),
+		RuleMoveValidator(path.Root("relative_position"), path.Root("target_rule")),
+	}
+}
+
+// Configure prepares the struct.
+func (r *trafficSteeringRulesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*sase.Client)
+}
+
+// Create resource
+func (r *trafficSteeringRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state trafficSteeringRulesRsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_traffic_steering_rules", "create", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource create", map[string]any{
+		"terraform_provider_function": "Create",
+		"resource_name":               "sase_traffic_steering_rules",
+		"folder":                      state.Folder.ValueString(),
+	})
+
+	// Prepare to create the config.
+	svc := wWVKIJO.NewClient(r.client)
+	input := wWVKIJO.CreateInput{
+		Folder: state.Folder.ValueString(),
+	}
+	var var0 hVbNsPq.Config
+	var var1 *hVbNsPq.ActionObject
+	if state.Action != nil {
+		var1 = &hVbNsPq.ActionObject{}
+		var var2 *hVbNsPq.ForwardObject
+		if state.Action.Forward != nil {
+			var2 = &hVbNsPq.ForwardObject{}
+			var2.Target = state.Action.Forward.Target.ValueString()
+		}
+		var1.Forward = var2
+		if state.Action.NoPbf.ValueBool() {
+			var1.NoPbf = struct{}{}
+		}
+	}
+	var0.Action = var1
+	var0.Category = DecodeStringSlice(state.Category)
+	var0.Destination = DecodeStringSlice(state.Destination)
+	var0.Name = state.Name.ValueString()
+	var0.Service = DecodeStringSlice(state.Service)
+	var0.Source = DecodeStringSlice(state.Source)
+	var0.SourceUser = DecodeStringSlice(state.SourceUser)
+	input.Config = var0
+
+	// Perform the operation.
+	ans, err := svc.Create(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(input.Folder)
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(ans.ObjectId)
+	state.Id = types.StringValue(idBuilder.String())
+	var var3 *trafficSteeringRulesRsModelActionObject
+	if ans.Action != nil {
+		var3 = &trafficSteeringRulesRsModelActionObject{}
+		var var4 *trafficSteeringRulesRsModelForwardObject
+		if ans.Action.Forward != nil {
+			var4 = &trafficSteeringRulesRsModelForwardObject{}
+			var4.Target = types.StringValue(ans.Action.Forward.Target)
+		}
+		var3.Forward = var4
+		if ans.Action.NoPbf != nil {
+			var3.NoPbf = types.BoolValue(true)
+		}
+	}
+	state.Action = var3
+	state.Category = EncodeStringSlice(ans.Category)
+	state.Destination = EncodeStringSlice(ans.Destination)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.Name = types.StringValue(ans.Name)
+	state.Service = EncodeStringSlice(ans.Service)
+	state.Source = EncodeStringSlice(ans.Source)
+	state.SourceUser = EncodeStringSlice(ans.SourceUser)
+
+	// Move the rule.  The rule exists now, so it is saved to state even if
+	// the move fails.
+	if !state.RelativePosition.IsNull() {
+		moveInput := wWVKIJO.MoveInput{
+			ObjectId:        ans.ObjectId,
+			Destination:     state.RelativePosition.ValueString(),
+			DestinationRule: state.TargetRule.ValueString(),
+		}
+		if err = svc.Move(ctx, moveInput); err != nil {
+			resp.Diagnostics.AddError("Error moving rule", OperationError(ctx, err))
+		}
+	}
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read resource.
+func (r *trafficSteeringRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var idType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &idType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := idType.ValueString()
+	tokens := strings.Split(id, IdSeparator)
+	if len(tokens) != 2 {
+		resp.Diagnostics.AddError("Error in resource ID format", "Expected 2 tokens")
+		return
+	}
+
+	var state trafficSteeringRulesRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("relative_position"), &state.RelativePosition)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("target_rule"), &state.TargetRule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_traffic_steering_rules", "read", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource read", map[string]any{
+		"terraform_provider_function": "Read",
+		"resource_name":               "sase_traffic_steering_rules",
+		"locMap":                      map[string]int{"Folder": 0, "ObjectId": 1},
+		"tokens":                      tokens,
+	})
+
+	// Prepare to read the config.
+	svc := wWVKIJO.NewClient(r.client)
+	input := wWVKIJO.ReadInput{
+		ObjectId: tokens[1],
+		Folder:   tokens[0],
+	}
+
+	// Perform the operation.
+	ans, err := svc.Read(ctx, input)
+	if err != nil {
+		if IsObjectNotFound(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
+		}
+		return
+	}
+
+	// Store the answer to state.
+	state.Folder = types.StringValue(tokens[0])
+	state.Id = idType
+	var var0 *trafficSteeringRulesRsModelActionObject
+	if ans.Action != nil {
+		var0 = &trafficSteeringRulesRsModelActionObject{}
+		var var1 *trafficSteeringRulesRsModelForwardObject
+		if ans.Action.Forward != nil {
+			var1 = &trafficSteeringRulesRsModelForwardObject{}
+			var1.Target = types.StringValue(ans.Action.Forward.Target)
+		}
+		var0.Forward = var1
+		if ans.Action.NoPbf != nil {
+			var0.NoPbf = types.BoolValue(true)
+		}
+	}
+	state.Action = var0
+	state.Category = EncodeStringSlice(ans.Category)
+	state.Destination = EncodeStringSlice(ans.Destination)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.Name = types.StringValue(ans.Name)
+	state.Service = EncodeStringSlice(ans.Service)
+	state.Source = EncodeStringSlice(ans.Source)
+	state.SourceUser = EncodeStringSlice(ans.SourceUser)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update resource.
+func (r *trafficSteeringRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state trafficSteeringRulesRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_traffic_steering_rules", "update", plan.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource update", map[string]any{
+		"terraform_provider_function": "Update",
+		"resource_name":               "sase_traffic_steering_rules",
+		"object_id":                   state.ObjectId.ValueString(),
+	})
+
+	// Prepare to create the config.
+	svc := wWVKIJO.NewClient(r.client)
+	input := wWVKIJO.UpdateInput{
+		ObjectId: state.ObjectId.ValueString(),
+	}
+	var var0 hVbNsPq.Config
+	var var1 *hVbNsPq.ActionObject
+	if plan.Action != nil {
+		var1 = &hVbNsPq.ActionObject{}
+		var var2 *hVbNsPq.ForwardObject
+		if plan.Action.Forward != nil {
+			var2 = &hVbNsPq.ForwardObject{}
+			var2.Target = plan.Action.Forward.Target.ValueString()
+		}
+		var1.Forward = var2
+		if plan.Action.NoPbf.ValueBool() {
+			var1.NoPbf = struct{}{}
+		}
+	}
+	var0.Action = var1
+	var0.Category = DecodeStringSlice(plan.Category)
+	var0.Destination = DecodeStringSlice(plan.Destination)
+	var0.Name = plan.Name.ValueString()
+	var0.Service = DecodeStringSlice(plan.Service)
+	var0.Source = DecodeStringSlice(plan.Source)
+	var0.SourceUser = DecodeStringSlice(plan.SourceUser)
+	input.Config = var0
+
+	// Perform the operation.
+	ans, err := svc.Update(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
+	var var3 *trafficSteeringRulesRsModelActionObject
+	if ans.Action != nil {
+		var3 = &trafficSteeringRulesRsModelActionObject{}
+		var var4 *trafficSteeringRulesRsModelForwardObject
+		if ans.Action.Forward != nil {
+			var4 = &trafficSteeringRulesRsModelForwardObject{}
+			var4.Target = types.StringValue(ans.Action.Forward.Target)
+		}
+		var3.Forward = var4
+		if ans.Action.NoPbf != nil {
+			var3.NoPbf = types.BoolValue(true)
+		}
+	}
+	state.Action = var3
+	state.Category = EncodeStringSlice(ans.Category)
+	state.Destination = EncodeStringSlice(ans.Destination)
+	state.ObjectId = types.StringValue(ans.ObjectId)
+	state.Name = types.StringValue(ans.Name)
+	state.Service = EncodeStringSlice(ans.Service)
+	state.Source = EncodeStringSlice(ans.Source)
+	state.SourceUser = EncodeStringSlice(ans.SourceUser)
+
+	// Move the rule.  The update was applied, so it is saved to state even if
+	// the move fails.  The prior placement is kept in that case, or cleared if
+	// it was the same, so that the next apply retries the move.
+	if !plan.RelativePosition.IsNull() {
+		moveInput := wWVKIJO.MoveInput{
+			ObjectId:        state.ObjectId.ValueString(),
+			Destination:     plan.RelativePosition.ValueString(),
+			DestinationRule: plan.TargetRule.ValueString(),
+		}
+		if err = svc.Move(ctx, moveInput); err != nil {
+			resp.Diagnostics.AddError("Error moving rule", OperationError(ctx, err))
+			if state.RelativePosition.Equal(plan.RelativePosition) && state.TargetRule.Equal(plan.TargetRule) {
+				state.RelativePosition = types.StringNull()
+				state.TargetRule = types.StringNull()
+			}
+		}
+	}
+	if !resp.Diagnostics.HasError() {
+		state.RelativePosition = plan.RelativePosition
+		state.TargetRule = plan.TargetRule
+	}
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete resource.
+func (r *trafficSteeringRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var idType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &idType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_traffic_steering_rules", "delete", timeouts)
+	defer cancel()
+
+	id := idType.ValueString()
+	tokens := strings.Split(id, IdSeparator)
+	if len(tokens) != 2 {
+		resp.Diagnostics.AddError("Error in resource ID format", "Expected 2 tokens")
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource delete", map[string]any{
+		"terraform_provider_function": "Delete",
+		"resource_name":               "sase_traffic_steering_rules",
+		"locMap":                      map[string]int{"Folder": 0, "ObjectId": 1},
+		"tokens":                      tokens,
+	})
+
+	svc := wWVKIJO.NewClient(r.client)
+	input := wWVKIJO.DeleteInput{
+		ObjectId: tokens[1],
+	}
+
+	// Perform the operation.
+	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
+	}
+}
+
+// ImportState imports by either the resource ID or by location params and name,
+// such as `folder=...,name=...`.
+func (r *trafficSteeringRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	params, ok, err := ParseImportId(req.ID, "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_traffic_steering_rules",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := wWVKIJO.NewClient(r.client)
+	input := wWVKIJO.ListInput{
+		Folder: params["folder"],
+		Name:   api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
+}
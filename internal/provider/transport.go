@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// Defaults for the retry provider params.
+	DefaultMaxRetries = 5
+	DefaultMinBackoff = "1s"
+	DefaultMaxBackoff = "30s"
+)
+
+// RetryTransport retries requests that were throttled by the API or that
+// failed with a transient server error, waiting between attempts with an
+// exponential backoff.  A `Retry-After` header sent along with the failure
+// overrides the backoff.
+//
+// Throttled requests were not processed, so they are always retried.  Server
+// errors and connection errors are only retried for idempotent methods, as
+// the request may have been processed regardless.
+type RetryTransport struct {
+	Transport  http.RoundTripper
+	MaxRetries int
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// Limiter is shared by all requests sent through this transport.  If nil,
+	// requests are not rate limited.
+	Limiter *RateLimiter
+}
+
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	// Buffer the body if it can't be read again for a retry.
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		if err := t.Limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		r := req
+		switch {
+		case body != nil:
+			r = req.Clone(ctx)
+			r.Body = io.NopCloser(bytes.NewReader(body))
+		case attempt > 0 && req.GetBody != nil:
+			b, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r = req.Clone(ctx)
+			r.Body = b
+		}
+
+		resp, err := t.Transport.RoundTrip(r)
+		if attempt >= t.MaxRetries || !shouldRetry(req, resp, err) || ctx.Err() != nil {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+
+		// Basic logging.
+		fields := map[string]any{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = resp.StatusCode
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		tflog.Warn(ctx, "Retrying request", fields)
+
+		if err = sleepContext(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// backoff returns how long to wait before the next attempt.
+func (t *RetryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		// Retrying any sooner would just be throttled again, so this is
+		// only bounded by the context deadline.
+		if d, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return d
+		}
+	}
+
+	// Double the wait per attempt, stopping at the max before it can overflow.
+	d := t.MinBackoff
+	for i := 0; i < attempt && d < t.MaxBackoff; i++ {
+		if d > t.MaxBackoff/2 {
+			d = t.MaxBackoff
+			break
+		}
+		d *= 2
+	}
+	if d > t.MaxBackoff {
+		d = t.MaxBackoff
+	}
+
+	// Add jitter so that parallel requests don't retry in lockstep.
+	lo := d / 2
+	if lo < t.MinBackoff {
+		lo = t.MinBackoff
+	}
+	if d > lo {
+		d = lo + time.Duration(rand.Int63n(int64(d-lo)))
+	}
+
+	return d
+}
+
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	var idempotent bool
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		idempotent = true
+	}
+
+	if err != nil {
+		return idempotent
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return idempotent
+	}
+
+	return false
+}
+
+// retryAfter parses a `Retry-After` header, which is either a number of
+// seconds or an HTTP date.
+func retryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.ParseUint(v, 10, 32); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if at, err := http.ParseTime(v); err == nil {
+		d := time.Until(at)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
+
+// RateLimiter spaces out requests so that no more than the given number of
+// requests per second are sent.
+type RateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// NewRateLimiter returns a rate limiter, or nil if rps is not positive.
+func NewRateLimiter(rps float64) *RateLimiter {
+	if rps <= 0 {
+		return nil
+	}
+
+	return &RateLimiter{
+		interval: time.Duration(float64(time.Second) / rps),
+	}
+}
+
+// Wait blocks until the next request may be sent.
+func (o *RateLimiter) Wait(ctx context.Context) error {
+	if o == nil {
+		return nil
+	}
+
+	o.mu.Lock()
+	now := time.Now()
+	at := o.next
+	if at.Before(now) {
+		at = now
+	}
+	o.next = at.Add(o.interval)
+	o.mu.Unlock()
+
+	if !at.After(now) {
+		return nil
+	}
+
+	return sleepContext(ctx, at.Sub(now))
+}
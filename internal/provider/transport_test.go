@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// stubServer returns a server that responds with the given status codes in
+// order, then with 200 for every request after that.
+func stubServer(t *testing.T, header http.Header, codes ...int) (*httptest.Server, *int32) {
+	t.Helper()
+
+	var count int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(atomic.AddInt32(&count, 1))
+		if n > len(codes) {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		for k, v := range header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(codes[n-1])
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &count
+}
+
+func retryClient(maxRetries int, minBackoff, maxBackoff time.Duration, limiter *RateLimiter) *http.Client {
+	return &http.Client{
+		Transport: &RetryTransport{
+			Transport:  http.DefaultTransport,
+			MaxRetries: maxRetries,
+			MinBackoff: minBackoff,
+			MaxBackoff: maxBackoff,
+			Limiter:    limiter,
+		},
+	}
+}
+
+func TestRetryTransportRetriesThrottled(t *testing.T) {
+	srv, count := stubServer(t, nil, http.StatusTooManyRequests, http.StatusTooManyRequests)
+	client := retryClient(5, time.Millisecond, 10*time.Millisecond, nil)
+
+	resp, err := client.Post(srv.URL, "application/json", strings.NewReader(`{}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status is %d, not %d", resp.StatusCode, http.StatusOK)
+	}
+	if *count != 3 {
+		t.Errorf("sent %d requests, not 3", *count)
+	}
+}
+
+func TestRetryTransportResendsBody(t *testing.T) {
+	var bodies []string
+	var count int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if atomic.AddInt32(&count, 1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+	client := retryClient(5, time.Millisecond, 10*time.Millisecond, nil)
+
+	resp, err := client.Post(srv.URL, "application/json", io.NopCloser(strings.NewReader(`{"name":"x"}`)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if len(bodies) != 2 || bodies[0] != `{"name":"x"}` || bodies[1] != bodies[0] {
+		t.Errorf("bodies sent: %q", bodies)
+	}
+}
+
+func TestRetryTransportServerErrors(t *testing.T) {
+	codes := []int{
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	}
+
+	for _, code := range codes {
+		srv, count := stubServer(t, nil, code)
+		client := retryClient(5, time.Millisecond, 10*time.Millisecond, nil)
+
+		// Idempotent requests are retried.
+		resp, err := client.Get(srv.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK || *count != 2 {
+			t.Errorf("GET after %d: status %d after %d requests", code, resp.StatusCode, *count)
+		}
+
+		// Non-idempotent requests may have been processed, so they are not.
+		srv, count = stubServer(t, nil, code)
+		resp, err = client.Post(srv.URL, "application/json", strings.NewReader(`{}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != code || *count != 1 {
+			t.Errorf("POST after %d: status %d after %d requests", code, resp.StatusCode, *count)
+		}
+	}
+}
+
+func TestRetryTransportStopsAtMaxRetries(t *testing.T) {
+	srv, count := stubServer(t, nil, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+	client := retryClient(2, time.Millisecond, 10*time.Millisecond, nil)
+
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("status is %d, not %d", resp.StatusCode, http.StatusServiceUnavailable)
+	}
+	if *count != 3 {
+		t.Errorf("sent %d requests, not 3", *count)
+	}
+}
+
+func TestRetryTransportHonorsRetryAfter(t *testing.T) {
+	srv, count := stubServer(t, http.Header{"Retry-After": []string{"1"}}, http.StatusTooManyRequests)
+	client := retryClient(5, time.Millisecond, 10*time.Second, nil)
+
+	start := time.Now()
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %s, before Retry-After", elapsed)
+	}
+	if *count != 2 {
+		t.Errorf("sent %d requests, not 2", *count)
+	}
+}
+
+func TestRetryTransportRetryAfterExceedsMaxBackoff(t *testing.T) {
+	srv, count := stubServer(t, http.Header{"Retry-After": []string{"1"}}, http.StatusTooManyRequests)
+	client := retryClient(5, time.Millisecond, 10*time.Millisecond, nil)
+
+	start := time.Now()
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %s, before Retry-After", elapsed)
+	}
+	if *count != 2 {
+		t.Errorf("sent %d requests, not 2", *count)
+	}
+}
+
+func TestRetryTransportRetryAfterBoundedByContext(t *testing.T) {
+	srv, count := stubServer(t, http.Header{"Retry-After": []string{"3600"}}, http.StatusTooManyRequests)
+	client := retryClient(5, time.Millisecond, 10*time.Millisecond, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err == nil {
+		resp.Body.Close()
+		t.Fatalf("request succeeded, expected the context deadline to be hit")
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("gave up after %s, not at the context deadline", elapsed)
+	}
+	if *count != 1 {
+		t.Errorf("sent %d requests, not 1", *count)
+	}
+}
+
+func TestRetryTransportBackoffSaturates(t *testing.T) {
+	rt := &RetryTransport{
+		MinBackoff: time.Hour,
+		MaxBackoff: 2 * time.Hour,
+	}
+
+	for attempt := 0; attempt < 100; attempt++ {
+		d := rt.backoff(attempt, nil)
+		if d < rt.MinBackoff || d > rt.MaxBackoff {
+			t.Fatalf("attempt %d: backoff %s is outside of [%s, %s]", attempt, d, rt.MinBackoff, rt.MaxBackoff)
+		}
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	srv, count := stubServer(t, nil)
+	client := retryClient(0, time.Millisecond, 10*time.Millisecond, NewRateLimiter(20))
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		resp, err := client.Get(srv.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	// The first request is sent right away, then one every 50ms.
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("sent %d requests in %s, faster than 20 per second", *count, elapsed)
+	}
+}
+
+func TestNewRateLimiterDisabled(t *testing.T) {
+	if NewRateLimiter(0) != nil {
+		t.Errorf("rate limiter is enabled for 0 requests per second")
+	}
+}
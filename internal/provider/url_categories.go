@@ -7,11 +7,19 @@ import (
 
 	"github.com/paloaltonetworks/sase-go"
 	"github.com/paloaltonetworks/sase-go/api"
+	nQhFzWy "github.com/paloaltonetworks/sase-go/netsec/schema/url/categories"
 	lkvgEEP "github.com/paloaltonetworks/sase-go/netsec/service/v1/urlcategories"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rsschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -35,10 +43,13 @@ type urlCategoriesListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Name   types.String `tfsdk:"name"`
-	Folder types.String `tfsdk:"folder"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Name     types.String `tfsdk:"name"`
+	Folder   types.String `tfsdk:"folder"`
 
 	// Output.
 	Data []urlCategoriesListDsModelConfig `tfsdk:"data"`
@@ -84,6 +95,27 @@ func (d *urlCategoriesListDataSource) Schema(_ context.Context, _ datasource.Sch
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"name": dsschema.StringAttribute{
 				Description:         "The name of the entry.",
 				MarkdownDescription: "The name of the entry.",
@@ -182,12 +214,32 @@ func (d *urlCategoriesListDataSource) Read(ctx context.Context, req datasource.R
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -234,3 +286,383 @@ func (d *urlCategoriesListDataSource) Read(ctx context.Context, req datasource.R
 	// Done.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// Resource.
+var (
+	_ resource.Resource                = &urlCategoriesResource{}
+	_ resource.ResourceWithConfigure   = &urlCategoriesResource{}
+	_ resource.ResourceWithImportState = &urlCategoriesResource{}
+)
+
+func NewUrlCategoriesResource() resource.Resource {
+	return &urlCategoriesResource{}
+}
+
+type urlCategoriesResource struct {
+	client *sase.Client
+}
+
+type urlCategoriesRsModel struct {
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
+
+	// Input.
+	Folder types.String `tfsdk:"folder"`
+
+	// Request body input.
+	// Ref: #/components/schemas/url-categories
+	Description types.String   `tfsdk:"description"`
+	List        []types.String `tfsdk:"list"`
+	Name        types.String   `tfsdk:"name"`
+	Type        types.String   `tfsdk:"type"`
+}
+
+// Metadata returns the data source type name.
+func (r *urlCategoriesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_url_categories"
+}
+
+// Schema defines the schema for this listing data source.
+func (r *urlCategoriesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rsschema.Schema{
+		Description: "Manages a custom URL category, keyed by name.",
+
+		Attributes: map[string]rsschema.Attribute{
+			"id": rsschema.StringAttribute{
+				Description:         "The object ID.",
+				MarkdownDescription: "The object ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Input.
+			"folder": rsschema.StringAttribute{
+				Description:         "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				MarkdownDescription: "The folder of the entry. Value must be one of: `\"Shared\"`, `\"Mobile Users\"`, `\"Remote Networks\"`, `\"Service Connections\"`, `\"Mobile Users Container\"`, `\"Mobile Users Explicit Proxy\"`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"description": rsschema.StringAttribute{
+				Description:         "The `description` parameter. String length must be at most 255.",
+				MarkdownDescription: "The `description` parameter. String length must be at most 255.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					DefaultString(""),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"list": rsschema.SetAttribute{
+				Description:         "The URLs of a `\"URL List\"` category, or the URL categories of a `\"Category Match\"` category. This is a set, so only the entries added or removed are shown in the plan. Each entry's length must be at most 255.",
+				MarkdownDescription: "The URLs of a `\"URL List\"` category, or the URL categories of a `\"Category Match\"` category. This is a set, so only the entries added or removed are shown in the plan. Each entry's length must be at most 255.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 255)),
+				},
+			},
+			"name": rsschema.StringAttribute{
+				Description:         "The `name` parameter. String length must be at most 31.",
+				MarkdownDescription: "The `name` parameter. String length must be at most 31.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(31),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": rsschema.StringAttribute{
+				Description:         "The `type` parameter. Value must be one of: `\"URL List\"`, `\"Category Match\"`. Default: `\"URL List\"`.",
+				MarkdownDescription: "The `type` parameter. Value must be one of: `\"URL List\"`, `\"Category Match\"`. Default: `\"URL List\"`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					DefaultString("URL List"),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("URL List", "Category Match"),
+				},
+			},
+		},
+
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_url_categories"),
+		},
+	}
+}
+
+// Configure prepares the struct.
+func (r *urlCategoriesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*sase.Client)
+}
+
+// Create resource.
+func (r *urlCategoriesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state urlCategoriesRsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_url_categories", "create", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource create", map[string]any{
+		"terraform_provider_function": "Create",
+		"resource_name":               "sase_url_categories",
+		"folder":                      state.Folder.ValueString(),
+		"name":                        state.Name.ValueString(),
+		"list_count":                  len(state.List),
+	})
+
+	// Prepare to create the config.
+	svc := lkvgEEP.NewClient(r.client)
+	input := lkvgEEP.CreateInput{
+		Folder: state.Folder.ValueString(),
+	}
+	var var0 nQhFzWy.Config
+	var0.Description = state.Description.ValueString()
+	var0.List = DecodeStringSlice(state.List)
+	var0.Name = state.Name.ValueString()
+	var0.Type = state.Type.ValueString()
+	input.Config = var0
+
+	// Perform the operation.
+	ans, err := svc.Create(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(input.Folder)
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(ans.Name)
+	state.Id = types.StringValue(idBuilder.String())
+	state.Description = types.StringValue(ans.Description)
+	state.List = EncodeStringSet(ans.List)
+	state.Name = types.StringValue(ans.Name)
+	state.Type = types.StringValue(ans.Type)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read resource.
+func (r *urlCategoriesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var idType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &idType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := idType.ValueString()
+	tokens := strings.Split(id, IdSeparator)
+	if len(tokens) != 2 {
+		resp.Diagnostics.AddError("Error in resource ID format", "Expected 2 tokens")
+		return
+	}
+
+	var state urlCategoriesRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_url_categories", "read", state.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource read", map[string]any{
+		"terraform_provider_function": "Read",
+		"resource_name":               "sase_url_categories",
+		"locMap":                      map[string]int{"Folder": 0, "Name": 1},
+		"tokens":                      tokens,
+	})
+
+	// Prepare to read the config.
+	svc := lkvgEEP.NewClient(r.client)
+	input := lkvgEEP.ListInput{
+		Folder: tokens[0],
+		Name:   api.String(tokens[1]),
+	}
+
+	// Perform the operation.
+	list, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
+		return
+	}
+	var ans *nQhFzWy.Config
+	for i := range list.Data {
+		if list.Data[i].Name == tokens[1] {
+			ans = &list.Data[i]
+			break
+		}
+	}
+	if ans == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Store the answer to state.
+	state.Id = idType
+	state.Folder = types.StringValue(tokens[0])
+	state.Description = types.StringValue(ans.Description)
+	state.List = EncodeStringSet(ans.List)
+	state.Name = types.StringValue(ans.Name)
+	state.Type = types.StringValue(ans.Type)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update resource.
+func (r *urlCategoriesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state urlCategoriesRsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_url_categories", "update", plan.Timeouts)
+	defer cancel()
+
+	// Basic logging.
+	added, removed := StringSetChanges(state.List, plan.List)
+	tflog.Info(ctx, "performing resource update", map[string]any{
+		"terraform_provider_function": "Update",
+		"resource_name":               "sase_url_categories",
+		"folder":                      state.Folder.ValueString(),
+		"name":                        state.Name.ValueString(),
+		"list_added":                  len(added),
+		"list_removed":                len(removed),
+	})
+	tflog.Debug(ctx, "url category list changes", map[string]any{
+		"added":   added,
+		"removed": removed,
+	})
+
+	// Prepare to update the config.
+	svc := lkvgEEP.NewClient(r.client)
+	input := lkvgEEP.UpdateInput{
+		Folder: state.Folder.ValueString(),
+		Name:   state.Name.ValueString(),
+	}
+	var var0 nQhFzWy.Config
+	var0.Description = plan.Description.ValueString()
+	var0.List = DecodeStringSlice(plan.List)
+	var0.Name = plan.Name.ValueString()
+	var0.Type = plan.Type.ValueString()
+	input.Config = var0
+
+	// Perform the operation.
+	ans, err := svc.Update(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
+		return
+	}
+
+	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
+	state.Description = types.StringValue(ans.Description)
+	state.List = EncodeStringSet(ans.List)
+	state.Name = types.StringValue(ans.Name)
+	state.Type = types.StringValue(ans.Type)
+
+	// Done.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete resource.
+func (r *urlCategoriesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var idType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &idType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_url_categories", "delete", timeouts)
+	defer cancel()
+
+	id := idType.ValueString()
+	tokens := strings.Split(id, IdSeparator)
+	if len(tokens) != 2 {
+		resp.Diagnostics.AddError("Error in resource ID format", "Expected 2 tokens")
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource delete", map[string]any{
+		"terraform_provider_function": "Delete",
+		"resource_name":               "sase_url_categories",
+		"locMap":                      map[string]int{"Folder": 0, "Name": 1},
+		"tokens":                      tokens,
+	})
+
+	svc := lkvgEEP.NewClient(r.client)
+	input := lkvgEEP.DeleteInput{
+		Folder: tokens[0],
+		Name:   tokens[1],
+	}
+
+	// Perform the operation.
+	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
+	}
+}
+
+// ImportState imports by either the resource ID or by location params and name,
+// such as `folder=...,name=...`.
+func (r *urlCategoriesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	params, ok, err := ParseImportId(req.ID, "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_url_categories",
+		"params":                      params,
+	})
+
+	// Categories are keyed by name, so the canonical ID needs no lookup.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(params["name"])
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
+}
@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func IsIpAddress() validator.String {
+	return &stringFormat{
+		desc:  "value must be an IPv4 or IPv6 address",
+		check: isIpAddress,
+	}
+}
+
+func IsIpv4Address() validator.String {
+	return &stringFormat{
+		desc: "value must be an IPv4 address",
+		check: func(v string) bool {
+			return isIpAddress(v) && netip.MustParseAddr(v).Is4()
+		},
+	}
+}
+
+func IsIpv6Address() validator.String {
+	return &stringFormat{
+		desc: "value must be an IPv6 address",
+		check: func(v string) bool {
+			return isIpAddress(v) && netip.MustParseAddr(v).Is6()
+		},
+	}
+}
+
+func IsIpPrefix() validator.String {
+	return &stringFormat{
+		desc:  "value must be an IPv4 or IPv6 prefix in CIDR notation",
+		check: isIpPrefix,
+	}
+}
+
+func IsIpv4Prefix() validator.String {
+	return &stringFormat{
+		desc: "value must be an IPv4 prefix in CIDR notation",
+		check: func(v string) bool {
+			return isIpPrefix(v) && netip.MustParsePrefix(v).Addr().Is4()
+		},
+	}
+}
+
+func IsIpv6Prefix() validator.String {
+	return &stringFormat{
+		desc: "value must be an IPv6 prefix in CIDR notation",
+		check: func(v string) bool {
+			return isIpPrefix(v) && netip.MustParsePrefix(v).Addr().Is6()
+		},
+	}
+}
+
+// PrefixLengthAtMost checks that a prefix in CIDR notation is no smaller than
+// the given prefix length.  Values that are not prefixes are left to the other
+// validators.
+func PrefixLengthAtMost(bits int) validator.String {
+	return &stringFormat{
+		desc: "prefix length must be /" + strconv.Itoa(bits) + " or shorter",
+		check: func(v string) bool {
+			prefix, err := netip.ParsePrefix(v)
+			return err != nil || prefix.Bits() <= bits
+		},
+	}
+}
+
+func IsIpAddressOrPrefix() validator.String {
+	return &stringFormat{
+		desc: "value must be an IPv4 or IPv6 address, optionally with a prefix length",
+		check: func(v string) bool {
+			return isIpAddress(v) || isIpPrefix(v)
+		},
+	}
+}
+
+func IsIpAddressOrFqdn() validator.String {
+	return &stringFormat{
+		desc: "value must be an IPv4 or IPv6 address or a fully qualified domain name",
+		check: func(v string) bool {
+			return isIpAddress(v) || isFqdn(v)
+		},
+	}
+}
+
+func IsIpRange() validator.String {
+	return &stringFormat{
+		desc:  "value must be a range of IPv4 or IPv6 addresses, such as \"10.0.0.1-10.0.0.20\"",
+		check: isIpRange,
+	}
+}
+
+func IsIpWildcard() validator.String {
+	return &stringFormat{
+		desc:  "value must be an IPv4 address and wildcard mask, such as \"10.0.0.0/0.0.255.255\"",
+		check: isIpWildcard,
+	}
+}
+
+func IsFqdn() validator.String {
+	return &stringFormat{
+		desc:  "value must be a fully qualified domain name",
+		check: isFqdn,
+	}
+}
+
+func IsPortList() validator.String {
+	return &stringFormat{
+		desc:  "value must be a comma separated list of ports or port ranges from 1 to 65535, such as \"80,443,8000-8080\"",
+		check: isPortList,
+	}
+}
+
+func IsAsn() validator.String {
+	return &stringFormat{
+		desc:  "value must be a 2-byte or 4-byte AS number, in plain or dotted notation",
+		check: isAsn,
+	}
+}
+
+func IsDuration() validator.String {
+	return &stringFormat{
+		desc: "value must be a duration, such as \"30s\" or \"2h45m\"",
+		check: func(v string) bool {
+			d, err := time.ParseDuration(v)
+			return err == nil && d > 0
+		},
+	}
+}
+
+type stringFormat struct {
+	desc  string
+	check func(string) bool
+}
+
+func (o *stringFormat) Description(_ context.Context) string {
+	return o.desc
+}
+
+func (o *stringFormat) MarkdownDescription(ctx context.Context) string {
+	return o.Description(ctx)
+}
+
+func (o *stringFormat) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !o.check(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			o.Description(ctx),
+			req.ConfigValue.ValueString(),
+		))
+	}
+}
+
+func isIpAddress(v string) bool {
+	addr, err := netip.ParseAddr(v)
+	return err == nil && addr.Zone() == ""
+}
+
+func isIpPrefix(v string) bool {
+	_, err := netip.ParsePrefix(v)
+	return err == nil
+}
+
+func isIpRange(v string) bool {
+	first, last, ok := strings.Cut(v, "-")
+	if !ok || !isIpAddress(first) || !isIpAddress(last) {
+		return false
+	}
+
+	a, b := netip.MustParseAddr(first), netip.MustParseAddr(last)
+	return a.Is4() == b.Is4() && a.Compare(b) <= 0
+}
+
+func isIpWildcard(v string) bool {
+	addr, mask, ok := strings.Cut(v, "/")
+	if !ok || !isIpAddress(addr) || !isIpAddress(mask) {
+		return false
+	}
+
+	return netip.MustParseAddr(addr).Is4() && netip.MustParseAddr(mask).Is4()
+}
+
+// Labels may contain underscores, which hostnames don't allow but DNS names
+// such as SRV records and DKIM selectors use.
+var fqdnLabel = regexp.MustCompile(`^[a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9_])?$`)
+
+func isFqdn(v string) bool {
+	v = strings.TrimSuffix(v, ".")
+	if v == "" || len(v) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(v, ".") {
+		if !fqdnLabel.MatchString(label) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isPortList(v string) bool {
+	for _, item := range strings.Split(v, ",") {
+		first, last, ok := strings.Cut(item, "-")
+		if !ok {
+			last = first
+		}
+
+		a, err := strconv.ParseUint(first, 10, 16)
+		if err != nil || a == 0 {
+			return false
+		}
+		b, err := strconv.ParseUint(last, 10, 16)
+		if err != nil || a > b {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isAsn(v string) bool {
+	// Dotted notation, where "1.10" is 65546.
+	if high, low, ok := strings.Cut(v, "."); ok {
+		a, err := strconv.ParseUint(high, 10, 16)
+		if err != nil {
+			return false
+		}
+		b, err := strconv.ParseUint(low, 10, 16)
+		return err == nil && a+b > 0
+	}
+
+	num, err := strconv.ParseUint(v, 10, 32)
+	return err == nil && num > 0
+}
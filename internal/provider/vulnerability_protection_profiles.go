@@ -42,10 +42,13 @@ type vulnerabilityProtectionProfilesListDsModel struct {
 	Id types.String `tfsdk:"id"`
 
 	// Input.
-	Limit  types.Int64  `tfsdk:"limit"`
-	Offset types.Int64  `tfsdk:"offset"`
-	Name   types.String `tfsdk:"name"`
-	Folder types.String `tfsdk:"folder"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Offset   types.Int64  `tfsdk:"offset"`
+	FetchAll types.Bool   `tfsdk:"fetch_all"`
+	PageSize types.Int64  `tfsdk:"page_size"`
+	MaxPages types.Int64  `tfsdk:"max_pages"`
+	Name     types.String `tfsdk:"name"`
+	Folder   types.String `tfsdk:"folder"`
 
 	// Output.
 	Data []vulnerabilityProtectionProfilesListDsModelConfig `tfsdk:"data"`
@@ -139,6 +142,27 @@ func (d *vulnerabilityProtectionProfilesListDataSource) Schema(_ context.Context
 				Optional:            true,
 				Computed:            true,
 			},
+			"fetch_all": dsschema.BoolAttribute{
+				Description:         "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				MarkdownDescription: "Retrieve all pages of the listing and merge them into `data`. Defaults to `true` when `limit` is unset.",
+				Optional:            true,
+			},
+			"page_size": dsschema.Int64Attribute{
+				Description:         "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				MarkdownDescription: "The count per page when `fetch_all` is enabled. If `limit` is set, it is used as the page size instead. Default: `200`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_pages": dsschema.Int64Attribute{
+				Description:         "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				MarkdownDescription: "The max number of pages to retrieve when `fetch_all` is enabled. A value of `0` means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"name": dsschema.StringAttribute{
 				Description:         "The name of the entry.",
 				MarkdownDescription: "The name of the entry.",
@@ -461,12 +485,32 @@ func (d *vulnerabilityProtectionProfilesListDataSource) Read(ctx context.Context
 		input.Name = api.String(state.Name.ValueString())
 	}
 
+	fetchAll, pageSize, maxPages := ListPaging(state.FetchAll, state.Limit, state.PageSize, state.MaxPages)
+	if fetchAll && input.Limit == nil {
+		input.Limit = api.Int(pageSize)
+	}
+
 	// Perform the operation.
 	ans, err := svc.List(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting listing", err.Error())
 		return
 	}
+	if fetchAll {
+		resp.Diagnostics.Append(FetchAllPages(input.Offset, int64(len(ans.Data)), ans.Total, maxPages, func(offset int64) (int, error) {
+			pageInput := input
+			pageInput.Offset = api.Int(offset)
+			page, err := svc.List(ctx, pageInput)
+			if err != nil {
+				return 0, err
+			}
+			ans.Data = append(ans.Data, page.Data...)
+			return len(page.Data), nil
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Store the answer to state.
 	var idBuilder strings.Builder
@@ -1153,9 +1197,10 @@ func (d *vulnerabilityProtectionProfilesDataSource) Read(ctx context.Context, re
 
 // Resource.
 var (
-	_ resource.Resource                = &vulnerabilityProtectionProfilesResource{}
-	_ resource.ResourceWithConfigure   = &vulnerabilityProtectionProfilesResource{}
-	_ resource.ResourceWithImportState = &vulnerabilityProtectionProfilesResource{}
+	_ resource.Resource                     = &vulnerabilityProtectionProfilesResource{}
+	_ resource.ResourceWithConfigure        = &vulnerabilityProtectionProfilesResource{}
+	_ resource.ResourceWithConfigValidators = &vulnerabilityProtectionProfilesResource{}
+	_ resource.ResourceWithImportState      = &vulnerabilityProtectionProfilesResource{}
 )
 
 func NewVulnerabilityProtectionProfilesResource() resource.Resource {
@@ -1167,7 +1212,8 @@ type vulnerabilityProtectionProfilesResource struct {
 }
 
 type vulnerabilityProtectionProfilesRsModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String `tfsdk:"id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
 
 	// Input.
 	Folder types.String `tfsdk:"folder"`
@@ -1256,6 +1302,9 @@ func (r *vulnerabilityProtectionProfilesResource) Schema(_ context.Context, _ re
 				Validators: []validator.String{
 					stringvalidator.OneOf("Shared", "Mobile Users", "Remote Networks", "Service Connections", "Mobile Users Container", "Mobile Users Explicit Proxy"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 
 			"description": rsschema.StringAttribute{
@@ -1601,6 +1650,37 @@ func (r *vulnerabilityProtectionProfilesResource) Schema(_ context.Context, _ re
 				},
 			},
 		},
+		Blocks: map[string]rsschema.Block{
+			"timeouts": TimeoutsBlock("sase_vulnerability_protection_profiles"),
+		},
+	}
+}
+
+// ConfigValidators returns the checks for mutually exclusive attributes.
+func (r *vulnerabilityProtectionProfilesResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		ExactlyOneOfNested(
+			path.MatchRoot("rules").AtAnyListIndex().AtName("action"),
+			"alert",
+			"allow",
+			"block_ip",
+			"default",
+			"drop",
+			"reset_both",
+			"reset_client",
+			"reset_server",
+		),
+		ExactlyOneOfNested(
+			path.MatchRoot("threat_exception").AtAnyListIndex().AtName("action"),
+			"alert",
+			"allow",
+			"block_ip",
+			"default",
+			"drop",
+			"reset_both",
+			"reset_client",
+			"reset_server",
+		),
 	}
 }
 
@@ -1621,6 +1701,9 @@ func (r *vulnerabilityProtectionProfilesResource) Create(ctx context.Context, re
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_vulnerability_protection_profiles", "create", state.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource create", map[string]any{
 		"terraform_provider_function": "Create",
@@ -1757,7 +1840,7 @@ func (r *vulnerabilityProtectionProfilesResource) Create(ctx context.Context, re
 	// Perform the operation.
 	ans, err := svc.Create(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in create", err.Error())
+		resp.Diagnostics.AddError("Error in create", OperationError(ctx, err))
 		return
 	}
 
@@ -1906,6 +1989,13 @@ func (r *vulnerabilityProtectionProfilesResource) Read(ctx context.Context, req
 	}
 
 	var state vulnerabilityProtectionProfilesRsModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_vulnerability_protection_profiles", "read", state.Timeouts)
+	defer cancel()
 
 	// Basic logging.
 	tflog.Info(ctx, "performing resource read", map[string]any{
@@ -1928,7 +2018,7 @@ func (r *vulnerabilityProtectionProfilesResource) Read(ctx context.Context, req
 		if IsObjectNotFound(err) {
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error reading config", err.Error())
+			resp.Diagnostics.AddError("Error reading config", OperationError(ctx, err))
 		}
 		return
 	}
@@ -2073,6 +2163,9 @@ func (r *vulnerabilityProtectionProfilesResource) Update(ctx context.Context, re
 		return
 	}
 
+	ctx, cancel := WithTimeout(ctx, "sase_vulnerability_protection_profiles", "update", plan.Timeouts)
+	defer cancel()
+
 	// Basic logging.
 	tflog.Info(ctx, "performing resource update", map[string]any{
 		"terraform_provider_function": "Update",
@@ -2209,11 +2302,12 @@ func (r *vulnerabilityProtectionProfilesResource) Update(ctx context.Context, re
 	// Perform the operation.
 	ans, err := svc.Update(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error in update", err.Error())
+		resp.Diagnostics.AddError("Error in update", OperationError(ctx, err))
 		return
 	}
 
 	// Store the answer to state.
+	state.Timeouts = plan.Timeouts
 	var var15 []vulnerabilityProtectionProfilesRsModelRulesObject
 	if len(ans.Rules) != 0 {
 		var15 = make([]vulnerabilityProtectionProfilesRsModelRulesObject, 0, len(ans.Rules))
@@ -2345,6 +2439,16 @@ func (r *vulnerabilityProtectionProfilesResource) Delete(ctx context.Context, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var timeouts types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := WithTimeout(ctx, "sase_vulnerability_protection_profiles", "delete", timeouts)
+	defer cancel()
+
 	id := idType.ValueString()
 	tokens := strings.Split(id, IdSeparator)
 	if len(tokens) != 2 {
@@ -2367,10 +2471,59 @@ func (r *vulnerabilityProtectionProfilesResource) Delete(ctx context.Context, re
 
 	// Perform the operation.
 	if _, err := svc.Delete(ctx, input); err != nil && !IsObjectNotFound(err) {
-		resp.Diagnostics.AddError("Error in delete", err.Error())
+		resp.Diagnostics.AddError("Error in delete", OperationError(ctx, err))
 	}
 }
 
+// ImportState imports by either the resource ID or by location params and name,
+// such as `folder=...,name=...`.
 func (r *vulnerabilityProtectionProfilesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	params, ok, err := ParseImportId(req.ID, "folder", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import ID format", err.Error())
+		return
+	}
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Basic logging.
+	tflog.Info(ctx, "performing resource import", map[string]any{
+		"terraform_provider_function": "ImportState",
+		"resource_name":               "sase_vulnerability_protection_profiles",
+		"params":                      params,
+	})
+
+	// Prepare to look up the object ID by name.
+	svc := sImSpCX.NewClient(r.client)
+	input := sImSpCX.ListInput{
+		Folder: params["folder"],
+		Name:   api.String(params["name"]),
+	}
+
+	// Perform the operation.
+	ans, err := svc.List(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting listing", err.Error())
+		return
+	}
+	var ids []string
+	for _, x := range ans.Data {
+		if x.Name == params["name"] {
+			ids = append(ids, x.ObjectId)
+		}
+	}
+	objectId, err := ImportObjectId(params, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in import", err.Error())
+		return
+	}
+
+	// Store the canonical ID.
+	var idBuilder strings.Builder
+	idBuilder.WriteString(params["folder"])
+	idBuilder.WriteString(IdSeparator)
+	idBuilder.WriteString(objectId)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idBuilder.String())...)
 }